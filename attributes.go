@@ -0,0 +1,106 @@
+package mapreader
+
+import (
+	"reflect"
+	"sort"
+)
+
+// KeyValue is a dependency free equivalent of an OpenTelemetry attribute
+//
+// Value holds one of: bool, int64, float64, string, []bool, []int64, []float64 or []string,
+// mirroring the value types that OpenTelemetry attributes support.
+type KeyValue struct {
+	Key   string
+	Value any
+}
+
+// Attributes extracts the values at the paths given in spec (keyed by attribute name) as KeyValue pairs
+//
+// Numbers are returned as int64 where they can be without losing value, otherwise as float64.
+// Paths that are missing, or which hold values that can't be represented as an attribute (such as maps),
+// are skipped. The result is sorted by key.
+func Attributes(source map[string]any, spec map[string]string) []KeyValue {
+	result := make([]KeyValue, 0, len(spec))
+	for key, path := range spec {
+		value, err := lookup(source, path, &defaultOptions)
+		if err != nil {
+			continue
+		}
+
+		if attr, ok := asAttributeValue(value); ok {
+			result = append(result, KeyValue{Key: key, Value: attr})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+
+	return result
+}
+
+// asAttributeValue converts a value into one of the types supported as an attribute value
+func asAttributeValue(value any) (any, bool) {
+	switch v := value.(type) {
+	case bool, string:
+		return v, true
+	case []any:
+		return asAttributeSlice(v)
+	}
+
+	if s, ok := anySliceValue(value, reflect.TypeFor[[]any]()); ok {
+		return asAttributeSlice(s.Interface().([]any))
+	}
+
+	if i, err := asNumberType[int64](value); err == nil {
+		return i, true
+	}
+
+	if f, err := asNumberType[float64](value); err == nil {
+		return f, true
+	}
+
+	if s, ok := asNativeType[string](value); ok {
+		return s, true
+	}
+
+	return nil, false
+}
+
+// asAttributeSlice converts a slice into a homogeneous slice of an attribute value type
+//
+// Integer and floating point elements are combined into []float64 if they can't all be represented as int64.
+func asAttributeSlice(in []any) (any, bool) {
+	if len(in) == 0 {
+		return nil, false
+	}
+
+	if result, err := asSliceType[string](in); err == nil {
+		return result, true
+	}
+
+	if result, err := asSliceType[bool](in); err == nil {
+		return result, true
+	}
+
+	ints := make([]int64, len(in))
+	floats := make([]float64, len(in))
+	allInts := true
+	for i, v := range in {
+		f, err := asNumberType[float64](v)
+		if err != nil {
+			return nil, false
+		}
+		floats[i] = f
+
+		if ints[i], err = asNumberType[int64](v); err != nil {
+			allInts = false
+		}
+	}
+
+	if allInts {
+		return ints, true
+	}
+
+	return floats, true
+}
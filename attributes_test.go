@@ -0,0 +1,71 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestAttributes(t *testing.T) {
+	source := map[string]any{}
+	err := json.Unmarshal([]byte(`{
+		"user": {"id": 42, "name": "jo", "admin": false, "score": 1.5},
+		"tags": ["a", "b"],
+		"counts": [1, 2],
+		"ratios": [1, 2.5],
+		"mixed": [1, "a"],
+		"meta": {"a": 1}
+	}`), &source)
+	if err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	spec := map[string]string{
+		"user.id":     "user.id",
+		"user.name":   "user.name",
+		"user.admin":  "user.admin",
+		"user.score":  "user.score",
+		"tags":        "tags",
+		"counts":      "counts",
+		"ratios":      "ratios",
+		"mixed":       "mixed",
+		"meta":        "meta",
+		"missing.key": "no.such.path",
+	}
+
+	expected := []KeyValue{
+		{Key: "counts", Value: []int64{1, 2}},
+		{Key: "ratios", Value: []float64{1, 2.5}},
+		{Key: "tags", Value: []string{"a", "b"}},
+		{Key: "user.admin", Value: false},
+		{Key: "user.id", Value: int64(42)},
+		{Key: "user.name", Value: "jo"},
+		{Key: "user.score", Value: 1.5},
+	}
+
+	if result := Attributes(source, spec); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected: %#v but got: %#v", expected, result)
+	}
+
+	typed := map[string]any{
+		"tags":    []string{"a", "b"},
+		"counts":  []int{1, 2},
+		"ids":     [2]int64{3, 4},
+		"ratios":  []float32{0.5, 1},
+		"flags":   []bool{true},
+		"records": []map[string]any{{"a": 1}},
+	}
+
+	spec = map[string]string{"tags": "tags", "counts": "counts", "ids": "ids", "ratios": "ratios", "flags": "flags", "records": "records"}
+	expected = []KeyValue{
+		{Key: "counts", Value: []int64{1, 2}},
+		{Key: "flags", Value: []bool{true}},
+		{Key: "ids", Value: []int64{3, 4}},
+		{Key: "ratios", Value: []float64{0.5, 1}},
+		{Key: "tags", Value: []string{"a", "b"}},
+	}
+
+	if result := Attributes(typed, spec); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected: %#v but got: %#v", expected, result)
+	}
+}
@@ -0,0 +1,179 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+// BigFloat returns the arbitrary precision number found at the given lookup path, ignoring any errors
+//
+// If any error is encountered, it returns nil.
+// Use mapreader.BigFloatErr if you would like errors to be returned
+func BigFloat(source map[string]any, path string) *big.Float {
+	return withoutError(BigFloatErr(source, path))
+}
+
+// BigFloatDefault returns the arbitrary precision number found at the given lookup path, or the default value
+//
+// The default is only returned for values that would otherwise error/aren't set.
+func BigFloatDefault(source map[string]any, path string, d *big.Float) *big.Float {
+	result, err := BigFloatErr(source, path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// BigFloatDefaultFunc returns the arbitrary precision number found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func BigFloatDefaultFunc(source map[string]any, path string, d func() *big.Float) *big.Float {
+	result, err := BigFloatErr(source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// BigFloatDefaultOK returns the arbitrary precision number found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func BigFloatDefaultOK(source map[string]any, path string, d *big.Float) (*big.Float, bool) {
+	result, err := BigFloatErr(source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// BigFloatErr returns the arbitrary precision number found at the given lookup path, or returns an error
+//
+// Use mapreader.BigFloat if you would like to ignore errors
+// Numbers, decimal strings and json.Number values are converted without loss of precision,
+// with strings parsed at a precision sufficient for all of their digits.
+func BigFloatErr(source map[string]any, path string) (*big.Float, error) {
+	return get(source, path, &defaultOptions, asBigFloat)
+}
+
+// BigInt returns the arbitrary precision integer found at the given lookup path, ignoring any errors
+//
+// If any error is encountered, it returns nil.
+// Use mapreader.BigIntErr if you would like errors to be returned
+func BigInt(source map[string]any, path string) *big.Int {
+	return withoutError(BigIntErr(source, path))
+}
+
+// BigIntDefault returns the arbitrary precision integer found at the given lookup path, or the default value
+//
+// The default is only returned for values that would otherwise error/aren't set.
+func BigIntDefault(source map[string]any, path string, d *big.Int) *big.Int {
+	result, err := BigIntErr(source, path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// BigIntDefaultFunc returns the arbitrary precision integer found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func BigIntDefaultFunc(source map[string]any, path string, d func() *big.Int) *big.Int {
+	result, err := BigIntErr(source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// BigIntDefaultOK returns the arbitrary precision integer found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func BigIntDefaultOK(source map[string]any, path string, d *big.Int) (*big.Int, bool) {
+	result, err := BigIntErr(source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// BigIntErr returns the arbitrary precision integer found at the given lookup path, or returns an error
+//
+// Use mapreader.BigInt if you would like to ignore errors
+// Integers, integral floats, decimal strings and json.Number values are converted without loss of precision.
+// Values with a fractional part return ErrUnableToConvert.
+func BigIntErr(source map[string]any, path string) (*big.Int, error) {
+	return get(source, path, &defaultOptions, asBigInt)
+}
+
+// asBigFloat converts a number, or a decimal string, into a *big.Float
+func asBigFloat(in any) (*big.Float, error) {
+	switch v := in.(type) {
+	case *big.Float:
+		return v, nil
+	case big.Float:
+		return &v, nil
+	case *big.Int:
+		return new(big.Float).SetInt(v), nil
+	case big.Int:
+		return new(big.Float).SetInt(&v), nil
+	case json.Number:
+		return parseBigFloat(v.String())
+	case string:
+		return parseBigFloat(strings.TrimSpace(v))
+	}
+
+	v := reflect.ValueOf(in)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Float).SetInt64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Float).SetUint64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(v.Float()) {
+			return nil, fmt.Errorf("%w: NaN has no big.Float value", ErrUnableToConvert)
+		}
+
+		return new(big.Float).SetFloat64(v.Float()), nil
+	default:
+		return nil, fmt.Errorf("%w: %T is not a supported numeric type", ErrUnexpectedType, in)
+	}
+}
+
+// asBigInt converts an integral number, or a decimal integer string, into a *big.Int
+func asBigInt(in any) (*big.Int, error) {
+	switch v := in.(type) {
+	case *big.Int:
+		return v, nil
+	case big.Int:
+		return &v, nil
+	case json.Number, string:
+		s := strings.TrimSpace(fmt.Sprint(v))
+		if i, ok := new(big.Int).SetString(s, 10); ok {
+			return i, nil
+		}
+	}
+
+	f, err := asBigFloat(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if !f.IsInt() {
+		return nil, fmt.Errorf("%w: '%v' cannot be converted to an equal value of type *big.Int", ErrUnableToConvert, in)
+	}
+
+	i, _ := f.Int(nil)
+	return i, nil
+}
+
+// parseBigFloat parses a decimal string into a *big.Float, with enough precision to represent all of its digits
+func parseBigFloat(s string) (*big.Float, error) {
+	f, _, err := big.ParseFloat(s, 10, max(64, uint(len(s))*4), big.ToNearestEven)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q is not a number", ErrUnableToConvert, s)
+	}
+
+	return f, nil
+}
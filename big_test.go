@@ -0,0 +1,101 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestBigIntErr(t *testing.T) {
+	source := map[string]any{
+		"string":   "123456789012345678901234567890",
+		"number":   json.Number("123456789012345678901234567890"),
+		"int":      int64(math.MaxInt64),
+		"uint":     uint64(math.MaxUint64),
+		"float":    float64(1e20),
+		"exponent": "1e3",
+		"fraction": 1.5,
+		"decimal":  "1.5",
+		"invalid":  "lots",
+		"bool":     true,
+		"big":      big.NewInt(7),
+	}
+
+	tests := map[string]struct {
+		path        string
+		expected    string
+		expectedErr error
+	}{
+		"Decimal string":   {path: "string", expected: "123456789012345678901234567890"},
+		"JSON number":      {path: "number", expected: "123456789012345678901234567890"},
+		"Max int64":        {path: "int", expected: "9223372036854775807"},
+		"Max uint64":       {path: "uint", expected: "18446744073709551615"},
+		"Integral float":   {path: "float", expected: "100000000000000000000"},
+		"Exponent":         {path: "exponent", expected: "1000"},
+		"Big value":        {path: "big", expected: "7"},
+		"Fractional float": {path: "fraction", expectedErr: ErrUnableToConvert},
+		"Decimal fraction": {path: "decimal", expectedErr: ErrUnableToConvert},
+		"Invalid string":   {path: "invalid", expectedErr: ErrUnableToConvert},
+		"Unsupported type": {path: "bool", expectedErr: ErrUnexpectedType},
+		"Missing key":      {path: "missing", expectedErr: ErrKeyNotFound},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := BigIntErr(source, tc.path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if tc.expectedErr == nil && result.String() != tc.expected {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+
+	if result := BigInt(source, "fraction"); result != nil {
+		t.Errorf("Expected: nil but got: %v", result)
+	}
+}
+
+func TestBigFloatErr(t *testing.T) {
+	source := map[string]any{
+		"string":  "12345678901234567890.123456789",
+		"number":  json.Number("0.1"),
+		"int":     int64(math.MaxInt64),
+		"float":   1.5,
+		"nan":     math.NaN(),
+		"invalid": "lots",
+		"bool":    true,
+	}
+
+	tests := map[string]struct {
+		path        string
+		expected    string
+		expectedErr error
+	}{
+		"Long decimal":     {path: "string", expected: "12345678901234567890.123456789"},
+		"JSON number":      {path: "number", expected: "0.1"},
+		"Max int64":        {path: "int", expected: "9223372036854775807"},
+		"Float":            {path: "float", expected: "1.5"},
+		"NaN":              {path: "nan", expectedErr: ErrUnableToConvert},
+		"Invalid string":   {path: "invalid", expectedErr: ErrUnableToConvert},
+		"Unsupported type": {path: "bool", expectedErr: ErrUnexpectedType},
+		"Missing key":      {path: "missing", expectedErr: ErrKeyNotFound},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := New(source).BigFloatErr(tc.path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if tc.expectedErr == nil && result.Text('f', -1) != tc.expected {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result.Text('f', -1))
+			}
+		})
+	}
+}
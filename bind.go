@@ -0,0 +1,91 @@
+package mapreader
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Bind populates the fields of the struct pointed to by dst from the lookup paths in their mapreader tags
+//
+// e.g. a field tagged `mapreader:"server.port"` is assigned the value found at "server.port".
+// Fields are converted following the same rules as mapreader.Scan, and fields whose paths aren't found
+// are left untouched (so they may be given defaults beforehand), unless tagged `mapreader:"server.port,required"`.
+// Struct fields tagged with a path that can't be converted directly have their own fields bound
+// relative to that path, while untagged struct fields are bound relative to the path of their parent.
+// Every field is attempted, and the errors of all failed fields are joined, each annotated with its path.
+func Bind(source map[string]any, dst any) error {
+	return bind(source, dst, &defaultOptions)
+}
+
+// bind populates the fields of the struct pointed to by dst
+func bind(source map[string]any, dst any, opts *Options) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: expected a non-nil struct pointer, got %T", ErrInvalidDestination, dst)
+	}
+
+	return errors.Join(bindStruct(source, "", v.Elem(), opts)...)
+}
+
+// bindStruct binds each tagged field of a struct, relative to the given path prefix
+func bindStruct(source map[string]any, prefix string, v reflect.Value, opts *Options) []error {
+	var errs []error
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		tag, ok := f.Tag.Lookup("mapreader")
+		if tag == "-" {
+			continue
+		}
+
+		path, options, _ := strings.Cut(tag, ",")
+		if !ok || path == "" {
+			if isBindableStruct(f.Type) {
+				errs = append(errs, bindStruct(source, prefix, v.Field(i), opts)...)
+			}
+			continue
+		}
+
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+
+		if isBindableStruct(f.Type) {
+			if _, err := lookup(source, path, opts); err == nil {
+				errs = append(errs, bindStruct(source, path, v.Field(i), opts)...)
+			} else if options == "required" {
+				errs = append(errs, fmt.Errorf("path '%s': %w", path, err))
+			}
+			continue
+		}
+
+		err := scanInto(source, path, v.Field(i).Addr().Interface(), opts)
+		if options != "required" && (errors.Is(err, ErrKeyNotFound) || errors.Is(err, ErrIndexOutOfBounds)) {
+			continue
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("path '%s': %w", path, err))
+		}
+	}
+
+	return errs
+}
+
+// isBindableStruct reports whether a field of the given type is bound field by field, rather than converted as a whole
+func isBindableStruct(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+
+	p := reflect.PointerTo(t)
+	return !p.Implements(reflect.TypeFor[encoding.TextUnmarshaler]()) && !p.Implements(reflect.TypeFor[json.Unmarshaler]())
+}
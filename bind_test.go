@@ -0,0 +1,82 @@
+package mapreader
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBind(t *testing.T) {
+	source := map[string]any{
+		"server": map[string]any{
+			"host":    "localhost",
+			"port":    float64(8080),
+			"timeout": "2023-01-02T03:04:05Z",
+			"grace":   float64(30),
+		},
+		"db": map[string]any{
+			"name":  "app",
+			"hosts": []any{"a", "b"},
+		},
+		"color": "red",
+	}
+
+	type database struct {
+		Name  string `mapreader:"name"`
+		Hosts []any  `mapreader:"hosts"`
+		Pool  int    `mapreader:"pool"`
+	}
+
+	var cfg struct {
+		Host     string        `mapreader:"server.host"`
+		Port     uint16        `mapreader:"server.port"`
+		Started  time.Time     `mapreader:"server.timeout"`
+		Grace    time.Duration `mapreader:"server.grace"`
+		Color    testColor     `mapreader:"color"`
+		Missing  string        `mapreader:"server.missing"`
+		Ignored  string        `mapreader:"-"`
+		Untagged string
+		DB       database `mapreader:"db"`
+		Nested   struct {
+			Name string `mapreader:"db.name"`
+		}
+	}
+	cfg.Missing = "default"
+	cfg.DB.Pool = 5
+
+	if err := Bind(source, &cfg); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if cfg.Host != "localhost" || cfg.Port != 8080 || cfg.Grace != 30 || cfg.Color != "red" || cfg.Missing != "default" {
+		t.Errorf("Unexpected bound values: %+v", cfg)
+	}
+
+	if !cfg.Started.Equal(time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("Expected: 2023-01-02T03:04:05Z but got: %v", cfg.Started)
+	}
+
+	if cfg.DB.Name != "app" || len(cfg.DB.Hosts) != 2 || cfg.DB.Pool != 5 || cfg.Nested.Name != "app" {
+		t.Errorf("Unexpected nested values: %+v, %+v", cfg.DB, cfg.Nested)
+	}
+
+	var invalid struct {
+		Host     int    `mapreader:"server.host"`
+		Port     string `mapreader:"server.port"`
+		Required string `mapreader:"server.user,required"`
+		Name     string `mapreader:"db.name"`
+	}
+
+	err := Bind(source, &invalid)
+	if !errors.Is(err, ErrUnexpectedType) || !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected ErrUnexpectedType and ErrKeyNotFound, but got: %v", err)
+	}
+
+	if invalid.Name != "app" {
+		t.Errorf("Expected: app but got: %v", invalid.Name)
+	}
+
+	if err := Bind(source, cfg); !errors.Is(err, ErrInvalidDestination) {
+		t.Errorf("Expected error: %v, but got: %v", ErrInvalidDestination, err)
+	}
+}
@@ -0,0 +1,65 @@
+package mapreader
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// Claims wraps a decoded token claims map, handling the common quirks of registered JWT claims
+type Claims map[string]any
+
+// Audience returns the aud claim, which may be either a single string or a list of strings
+func (c Claims) Audience() ([]string, error) {
+	return StrSliceFlexible(c, "aud")
+}
+
+// ExpiresAt returns the exp claim as a time
+//
+// NumericDate values may be either integer or fractional seconds since the epoch.
+func (c Claims) ExpiresAt() (time.Time, error) {
+	return c.NumericDate("exp")
+}
+
+// IssuedAt returns the iat claim as a time
+func (c Claims) IssuedAt() (time.Time, error) {
+	return c.NumericDate("iat")
+}
+
+// NotBefore returns the nbf claim as a time
+func (c Claims) NotBefore() (time.Time, error) {
+	return c.NumericDate("nbf")
+}
+
+// NumericDate returns the NumericDate value found at the given lookup path as a time
+func (c Claims) NumericDate(path string) (time.Time, error) {
+	seconds, err := Float64Err(c, path)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	whole, fraction := math.Modf(seconds)
+	return time.Unix(int64(whole), int64(fraction*float64(time.Second))), nil
+}
+
+// Scopes returns the granted scopes of the token
+//
+// The space separated scope claim (RFC 8693) is used if present, falling back to the scp claim,
+// which may be either a space separated string or a list of strings.
+func (c Claims) Scopes() ([]string, error) {
+	scopes, err := get(c, "scope", &defaultOptions, asScopes)
+	if err != nil {
+		return get(c, "scp", &defaultOptions, asScopes)
+	}
+
+	return scopes, nil
+}
+
+// asScopes converts either a space separated string or a list of strings into a slice of scopes
+func asScopes(value any) ([]string, error) {
+	if s, ok := value.(string); ok {
+		return strings.Fields(s), nil
+	}
+
+	return asStrOrStrSlice(value)
+}
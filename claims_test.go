@@ -0,0 +1,50 @@
+package mapreader
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestClaims(t *testing.T) {
+	single := Claims{"aud": "api", "exp": float64(1700000000.5), "iat": int64(1699990000), "scope": "read write"}
+	multi := Claims{"aud": []any{"api", "web"}, "scp": []any{"read"}}
+
+	if aud, err := single.Audience(); err != nil || !reflect.DeepEqual(aud, []string{"api"}) {
+		t.Errorf("Expected: [api] but got: %v (%v)", aud, err)
+	}
+
+	if aud, err := multi.Audience(); err != nil || !reflect.DeepEqual(aud, []string{"api", "web"}) {
+		t.Errorf("Expected: [api web] but got: %v (%v)", aud, err)
+	}
+
+	if _, err := (Claims{"aud": 42}).Audience(); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	expected := time.Unix(1700000000, int64(500*time.Millisecond))
+	if exp, err := single.ExpiresAt(); err != nil || !exp.Equal(expected) {
+		t.Errorf("Expected: %v but got: %v (%v)", expected, exp, err)
+	}
+
+	if iat, err := single.IssuedAt(); err != nil || !iat.Equal(time.Unix(1699990000, 0)) {
+		t.Errorf("Expected: %v but got: %v (%v)", time.Unix(1699990000, 0), iat, err)
+	}
+
+	if _, err := single.NotBefore(); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected error: %v, but got: %v", ErrKeyNotFound, err)
+	}
+
+	if scopes, err := single.Scopes(); err != nil || !reflect.DeepEqual(scopes, []string{"read", "write"}) {
+		t.Errorf("Expected: [read write] but got: %v (%v)", scopes, err)
+	}
+
+	if scopes, err := multi.Scopes(); err != nil || !reflect.DeepEqual(scopes, []string{"read"}) {
+		t.Errorf("Expected: [read] but got: %v (%v)", scopes, err)
+	}
+
+	if _, err := (Claims{}).Scopes(); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected error: %v, but got: %v", ErrKeyNotFound, err)
+	}
+}
@@ -0,0 +1,34 @@
+package mapreader
+
+import "fmt"
+
+// Column returns the values found at the given key of every object in the slice at the given array path
+//
+// The key may itself be a lookup path, evaluated against each object.
+// The result is allocated once at the length of the slice, and the first error encountered is returned,
+// annotated with the index of the object that failed.
+func Column[T any](source map[string]any, arrayPath, key string) ([]T, error) {
+	return column[T](source, arrayPath, key, &defaultOptions)
+}
+
+// column looks up the values at the given key of every object in the slice at the given array path
+func column[T any](source map[string]any, arrayPath, key string, opts *Options) ([]T, error) {
+	in, err := get(source, arrayPath, opts, asType[[]any])
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]T, len(in))
+	for i, element := range in {
+		object, ok := element.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("index %d: %w: expected an object, got %T", i, ErrUnexpectedType, element)
+		}
+
+		if result[i], err = get(object, key, opts, asType[T]); err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+	}
+
+	return result, nil
+}
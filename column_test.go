@@ -0,0 +1,51 @@
+package mapreader
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestColumn(t *testing.T) {
+	source := map[string]any{
+		"orders": []any{
+			map[string]any{"id": "a", "customer": map[string]any{"name": "Dan"}},
+			map[string]any{"id": "b", "customer": map[string]any{"name": "Jo"}},
+		},
+		"mixed": []any{map[string]any{"id": "a"}, "b"},
+	}
+
+	tests := map[string]struct {
+		arrayPath   string
+		key         string
+		expected    []string
+		expectedErr error
+	}{
+		"Single key":       {arrayPath: "orders", key: "id", expected: []string{"a", "b"}},
+		"Nested key":       {arrayPath: "orders", key: "customer.name", expected: []string{"Dan", "Jo"}},
+		"Missing key":      {arrayPath: "orders", key: "total", expectedErr: ErrKeyNotFound},
+		"Wrong type":       {arrayPath: "orders", key: "customer", expectedErr: ErrUnexpectedType},
+		"Missing array":    {arrayPath: "missing", key: "id", expectedErr: ErrKeyNotFound},
+		"Non-object entry": {arrayPath: "mixed", key: "id", expectedErr: ErrUnexpectedType},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := Column[string](source, tc.arrayPath, tc.key)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = Column[string](source, "orders", "customer.name")
+	})
+	if allocs > 1 {
+		t.Errorf("Expected: at most 1 allocation but got: %v", allocs)
+	}
+}
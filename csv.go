@@ -0,0 +1,99 @@
+package mapreader
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// WriteCSV writes the objects of the slice found at the given lookup path as CSV, with a header row of the columns
+//
+// Each column is a lookup path evaluated against every object of the slice.
+// If the array path is empty, the source itself must be the slice of objects.
+// Missing values are written as empty cells, numbers and bools in their plain form,
+// and nested maps or slices as JSON.
+func WriteCSV(w io.Writer, source any, arrayPath string, columns []string) error {
+	rows, err := csvRows(source, arrayPath)
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(columns); err != nil {
+		return err
+	}
+
+	record := make([]string, len(columns))
+	for i, row := range rows {
+		object, ok := row.(map[string]any)
+		if !ok {
+			return fmt.Errorf("index %d: %w: expected an object, got %T", i, ErrUnexpectedType, row)
+		}
+
+		for j, column := range columns {
+			value, err := lookup(object, column, &defaultOptions)
+			if errors.Is(err, ErrKeyNotFound) || errors.Is(err, ErrIndexOutOfBounds) {
+				value, err = nil, nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("index %d: %w", i, err)
+			}
+
+			if record[j], err = csvCell(value); err != nil {
+				return fmt.Errorf("index %d: %w", i, err)
+			}
+		}
+
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvRows returns the slice of rows found at the given lookup path of the source
+func csvRows(source any, arrayPath string) ([]any, error) {
+	if arrayPath == "" {
+		return asType[[]any](source)
+	}
+
+	m, ok := source.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected an object source for path '%s', got %T", ErrUnexpectedType, arrayPath, source)
+	}
+
+	return GetErr[[]any](m, arrayPath)
+}
+
+// csvCell formats a single value as the text of a CSV cell
+func csvCell(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case map[string]any, []any:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("%w: %w", ErrUnableToConvert, err)
+		}
+
+		return string(data), nil
+	}
+
+	if s, err := asStrLenient(value); err == nil {
+		return s, nil
+	}
+
+	return fmt.Sprint(value), nil
+}
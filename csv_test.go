@@ -0,0 +1,49 @@
+package mapreader
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestWriteCSV(t *testing.T) {
+	source := map[string]any{
+		"data": map[string]any{
+			"users": []any{
+				map[string]any{"name": "Dan", "age": float64(40), "admin": true, "tags": []any{"a", "b"}},
+				map[string]any{"name": "Smith, Jo", "address": map[string]any{"city": "Leeds"}, "age": 1.5},
+			},
+		},
+	}
+
+	var b strings.Builder
+	err := WriteCSV(&b, source, "data.users", []string{"name", "age", "admin", "address.city", "tags"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := "name,age,admin,address.city,tags\n" +
+		"Dan,40,true,,\"[\"\"a\"\",\"\"b\"\"]\"\n" +
+		"\"Smith, Jo\",1.5,,Leeds,\n"
+	if b.String() != expected {
+		t.Errorf("Expected: %q but got: %q", expected, b.String())
+	}
+
+	b.Reset()
+	rows := []any{map[string]any{"id": 1}}
+	if err := WriteCSV(&b, rows, "", []string{"id"}); err != nil || b.String() != "id\n1\n" {
+		t.Errorf("Expected: %q but got: %q (%v)", "id\n1\n", b.String(), err)
+	}
+
+	if err := WriteCSV(&b, source, "data.missing", []string{"name"}); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected error: %v, but got: %v", ErrKeyNotFound, err)
+	}
+
+	if err := WriteCSV(&b, []any{"x"}, "", []string{"name"}); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	if err := WriteCSV(&b, source, "data.users", []string{"name.first.x"}); !errors.Is(err, ErrEndOfNestedStructures) {
+		t.Errorf("Expected error: %v, but got: %v", ErrEndOfNestedStructures, err)
+	}
+}
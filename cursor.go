@@ -0,0 +1,157 @@
+package mapreader
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Cursor is a position within a Reader's source, allowing lookups relative to that position
+//
+// Paths given to a Cursor are resolved with mapreader.ResolvePath, so ".." moves up a level,
+// e.g. a Cursor at "items.3.price" reads "items.3.quantity" from "..quantity".
+// Errors are annotated with the absolute path that failed.
+type Cursor struct {
+	r    *Reader
+	path string
+	err  error
+}
+
+// At returns a Cursor positioned at the given lookup path
+//
+// The path isn't resolved until a value is read, so a Cursor may be positioned at a missing value.
+func (r *Reader) At(path string) *Cursor {
+	return &Cursor{r: r, path: path}
+}
+
+// At returns a Cursor positioned at the given path, relative to this one
+//
+// If the path moves above the root of the source, every read from the returned Cursor returns ErrInvalidPath.
+func (c *Cursor) At(rel string) *Cursor {
+	if c.err != nil {
+		return c
+	}
+
+	path, err := ResolvePath(c.path, rel)
+	if err != nil {
+		return &Cursor{r: c.r, path: c.path, err: err}
+	}
+
+	return &Cursor{r: c.r, path: path}
+}
+
+// Index returns a Cursor positioned at the given index of the slice at this position
+func (c *Cursor) Index(i int) *Cursor {
+	return &Cursor{r: c.r, path: joinPath(c.path, strconv.Itoa(i)), err: c.err}
+}
+
+// Len returns the length of the slice or map at this position, or 0 if there isn't one
+func (c *Cursor) Len() int {
+	value, err := c.Value()
+	if err != nil {
+		return 0
+	}
+
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return v.Len()
+	default:
+		return 0
+	}
+}
+
+// Parent returns a Cursor positioned one level above this one
+//
+// The parent of the root is the root itself.
+func (c *Cursor) Parent() *Cursor {
+	if c.path == "" {
+		return c
+	}
+
+	return c.At("..")
+}
+
+// Path returns the absolute lookup path of the Cursor
+func (c *Cursor) Path() string {
+	return c.path
+}
+
+// Value returns the value at the position of the Cursor, or returns an error
+func (c *Cursor) Value() (any, error) {
+	return cursorGet(c, "", func(v any) (any, error) { return v, nil })
+}
+
+// CursorRead is the Cursor equivalent of mapreader.Get, reading the given path relative to the Cursor
+func CursorRead[T any](c *Cursor, rel string) T {
+	return withoutError(CursorReadErr[T](c, rel))
+}
+
+// CursorReadErr is the Cursor equivalent of mapreader.GetErr, reading the given path relative to the Cursor
+func CursorReadErr[T any](c *Cursor, rel string) (T, error) {
+	return cursorGet(c, rel, asType[T])
+}
+
+// Bool is the Cursor equivalent of mapreader.Bool
+func (c *Cursor) Bool(rel string) bool {
+	return withoutError(c.BoolErr(rel))
+}
+
+// BoolErr is the Cursor equivalent of mapreader.BoolErr
+func (c *Cursor) BoolErr(rel string) (bool, error) {
+	return cursorGet(c, rel, asType[bool])
+}
+
+// Float64 is the Cursor equivalent of mapreader.Float64
+func (c *Cursor) Float64(rel string) float64 {
+	return withoutError(c.Float64Err(rel))
+}
+
+// Float64Err is the Cursor equivalent of mapreader.Float64Err
+func (c *Cursor) Float64Err(rel string) (float64, error) {
+	return cursorGet(c, rel, asNumber[float64](&c.r.opts))
+}
+
+// Int is the Cursor equivalent of mapreader.Int
+func (c *Cursor) Int(rel string) int {
+	return withoutError(c.IntErr(rel))
+}
+
+// IntErr is the Cursor equivalent of mapreader.IntErr
+func (c *Cursor) IntErr(rel string) (int, error) {
+	return cursorGet(c, rel, asNumber[int](&c.r.opts))
+}
+
+// Str is the Cursor equivalent of mapreader.Str
+func (c *Cursor) Str(rel string) string {
+	return withoutError(c.StrErr(rel))
+}
+
+// StrErr is the Cursor equivalent of mapreader.StrErr
+func (c *Cursor) StrErr(rel string) (string, error) {
+	return cursorGet(c, rel, asType[string])
+}
+
+// cursorGet reads the value at a path relative to the Cursor, annotating errors with the absolute path
+//
+// Errors already formatted by an ErrorFormatter are returned unchanged, so the formatter controls the whole message.
+func cursorGet[R any](c *Cursor, rel string, convert func(any) (R, error)) (R, error) {
+	if c.err != nil {
+		return *new(R), c.err
+	}
+
+	path, err := ResolvePath(c.path, rel)
+	if err != nil {
+		return *new(R), err
+	}
+
+	if path == "" {
+		return convert(c.r.source)
+	}
+
+	result, err := get(c.r.source, path, &c.r.opts, convert)
+	if err != nil && c.r.opts.ErrorFormatter == nil {
+		return result, fmt.Errorf("path '%s': %w", path, err)
+	}
+
+	return result, err
+}
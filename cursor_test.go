@@ -0,0 +1,140 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCursor(t *testing.T) {
+	source := map[string]any{}
+	err := json.Unmarshal([]byte(`{
+		"data": {
+			"currency": "GBP",
+			"items": [
+				{"name": "apple", "price": 0.5, "quantity": 3, "fresh": true},
+				{"name": "pear", "price": 0.75, "quantity": 1, "fresh": false}
+			]
+		}
+	}`), &source)
+	if err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	items := New(source).At("data.items")
+
+	var names []string
+	total := 0.0
+	for i := 0; i < items.Len(); i++ {
+		item := items.Index(i)
+		names = append(names, item.Str("name"))
+		total += item.Float64("price") * float64(item.Int("quantity"))
+	}
+
+	if strings.Join(names, ",") != "apple,pear" || total != 2.25 {
+		t.Errorf("Expected: apple,pear with total 2.25 but got: %v with %v", names, total)
+	}
+
+	price := items.Index(1).At("price")
+	if price.Path() != "data.items.1.price" {
+		t.Errorf("Expected: data.items.1.price but got: %s", price.Path())
+	}
+
+	if result, err := price.IntErr("..quantity"); err != nil || result != 1 {
+		t.Errorf("Expected: 1 but got: %d (%v)", result, err)
+	}
+
+	if result, err := price.Parent().Parent().Parent().StrErr("currency"); err != nil || result != "GBP" {
+		t.Errorf("Expected: GBP but got: %s (%v)", result, err)
+	}
+
+	if result, err := CursorReadErr[bool](price, "..fresh"); err != nil || result {
+		t.Errorf("Expected: false but got: %t (%v)", result, err)
+	}
+
+	if result, err := price.Float64Err(""); err != nil || result != 0.75 {
+		t.Errorf("Expected: 0.75 but got: %v (%v)", result, err)
+	}
+
+	_, err = items.Index(5).StrErr("name")
+	if !errors.Is(err, ErrIndexOutOfBounds) || !strings.Contains(err.Error(), "data.items.5.name") {
+		t.Errorf("Expected error: %v for path data.items.5.name, but got: %v", ErrIndexOutOfBounds, err)
+	}
+
+	root := items.Parent().Parent()
+	if root.Path() != "" || root.Len() != 1 {
+		t.Errorf("Expected the root cursor but got: %s (%d)", root.Path(), root.Len())
+	}
+
+	if _, err := root.StrErr("..data"); !errors.Is(err, ErrInvalidPath) {
+		t.Errorf("Expected error: %v, but got: %v", ErrInvalidPath, err)
+	}
+
+	above := items.At("......data")
+	if _, err := above.StrErr("currency"); !errors.Is(err, ErrInvalidPath) {
+		t.Errorf("Expected reads above the root to return: %v, but got: %v", ErrInvalidPath, err)
+	}
+
+	if _, err := above.Index(0).At("name").Value(); !errors.Is(err, ErrInvalidPath) {
+		t.Errorf("Expected cursors derived from an invalid cursor to return: %v, but got: %v", ErrInvalidPath, err)
+	}
+}
+
+func TestCursorRawSegments(t *testing.T) {
+	source := map[string]any{
+		"users": []any{
+			map[string]any{"id": 7, "email": "jo@example.com"},
+			map[string]any{"id": 42, "email": "dan@example.com"},
+		},
+	}
+
+	r := New(source)
+
+	if result, err := r.At("users.[id=42].id").StrErr("..email"); err != nil || result != "dan@example.com" {
+		t.Errorf("Expected: dan@example.com but got: %s (%v)", result, err)
+	}
+
+	emails := r.At("users.*.id").At("..email")
+	if emails.Path() != "users.*.email" {
+		t.Errorf("Expected: users.*.email but got: %s", emails.Path())
+	}
+
+	if result, err := GetAllErr[string](source, emails.Path()); err != nil || len(result) != 2 {
+		t.Errorf("Expected both emails but got: %v (%v)", result, err)
+	}
+}
+
+func TestCursorNumberParsing(t *testing.T) {
+	source := map[string]any{"item": map[string]any{"qty": "42", "price": "0.5"}}
+	item := New(source, WithNumberParsing()).At("item")
+
+	if result, err := item.IntErr("qty"); err != nil || result != 42 {
+		t.Errorf("Expected: 42 but got: %d (%v)", result, err)
+	}
+
+	if result, err := item.Float64Err("price"); err != nil || result != 0.5 {
+		t.Errorf("Expected: 0.5 but got: %v (%v)", result, err)
+	}
+
+	if _, err := New(source).At("item").IntErr("qty"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+}
+
+func TestCursorErrorFormatter(t *testing.T) {
+	source := map[string]any{"user": map[string]any{"name": "Dan"}}
+
+	r := New(source, WithErrorFormatter(func(info ErrorInfo) string {
+		return info.Path + " fehlt"
+	}))
+
+	_, err := r.At("user").StrErr("email")
+	if err == nil || err.Error() != "user.email fehlt" {
+		t.Errorf("Expected: user.email fehlt but got: %v", err)
+	}
+
+	if !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected error: %v, but got: %v", ErrKeyNotFound, err)
+	}
+}
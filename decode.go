@@ -0,0 +1,44 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Decode decodes the value at the given lookup path into the given type, ignoring any errors
+//
+// Use mapreader.DecodeErr if you would like errors to be returned
+func Decode[T any](source map[string]any, path string) T {
+	return withoutError(DecodeErr[T](source, path))
+}
+
+// DecodeErr decodes the value at the given lookup path into the given type, or returns an error
+//
+// e.g. DecodeErr[Address](source, "customer.shipping_address") decodes an object into an Address struct,
+// using its json tags. Values which can be converted as by mapreader.GetErr are returned directly,
+// while anything else (such as objects and arrays of objects) is decoded through its JSON encoding.
+func DecodeErr[T any](source map[string]any, path string) (T, error) {
+	return get(source, path, &defaultOptions, asDecodedType[T])
+}
+
+// asDecodedType converts a value into the target type, decoding via JSON if it can't otherwise be converted
+//
+// This allows objects to be decoded into structs using their json tags.
+func asDecodedType[T any](in any) (T, error) {
+	result, err := asType[T](in)
+	if !errors.Is(err, ErrUnexpectedType) {
+		return result, err
+	}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		return result, fmt.Errorf("%w: %w", ErrUnableToConvert, err)
+	}
+
+	if err := json.Unmarshal(data, &result); err != nil {
+		return result, fmt.Errorf("%w: %w", ErrUnableToConvert, err)
+	}
+
+	return result, nil
+}
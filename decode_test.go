@@ -0,0 +1,54 @@
+package mapreader
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeErr(t *testing.T) {
+	type address struct {
+		Street   string   `json:"street"`
+		Postcode string   `json:"post_code"`
+		Lines    []string `json:"lines"`
+		Ignored  string   `json:"-"`
+	}
+
+	source := map[string]any{
+		"customer": map[string]any{
+			"shipping_address": map[string]any{
+				"street":    "1 High St",
+				"post_code": "AB1 2CD",
+				"lines":     []any{"Flat 2", "Town"},
+				"Ignored":   "x",
+			},
+			"name": "Dan",
+		},
+	}
+
+	result, err := DecodeErr[address](source, "customer.shipping_address")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := address{Street: "1 High St", Postcode: "AB1 2CD", Lines: []string{"Flat 2", "Town"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected: %+v but got: %+v", expected, result)
+	}
+
+	if name := Decode[string](source, "customer.name"); name != "Dan" {
+		t.Errorf("Expected: Dan but got: %v", name)
+	}
+
+	if _, err := DecodeErr[address](source, "customer.billing_address"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected error: %v, but got: %v", ErrKeyNotFound, err)
+	}
+
+	if _, err := DecodeErr[address](source, "customer.name"); !errors.Is(err, ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, err)
+	}
+
+	if result := ReadDecode[address](New(source), "customer.shipping_address"); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected: %+v but got: %+v", expected, result)
+	}
+}
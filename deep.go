@@ -0,0 +1,89 @@
+package mapreader
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// DeepMap returns every leaf beneath the map at the given lookup path, converted to the given type and keyed by its path
+//
+// Nested maps and slices are traversed recursively, so {"db": {"hosts": ["a", "b"]}} becomes
+// {"db.hosts.0": "a", "db.hosts.1": "b"} with DeepMap[string]. Keys are lookup paths relative to the map,
+// with segments quoted by mapreader.QuoteSegment. Leaves are converted as by mapreader.GetErr,
+// and the first leaf that can't be converted returns an error annotated with its path.
+func DeepMap[T any](source map[string]any, path string) (map[string]T, error) {
+	m, err := GetErr[map[string]any](source, path)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]T)
+	if err := deepMapInto(result, "", m); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DeepSlice returns every leaf beneath the slice at the given lookup path, converted to the given type, in order
+//
+// Nested slices are flattened depth first, so [[1, 2], [3]] becomes [1, 2, 3] with DeepSlice[float64].
+// Any other values, including maps, are leaves converted as by mapreader.GetErr,
+// and the first leaf that can't be converted returns an error annotated with its index path.
+func DeepSlice[T any](source map[string]any, path string) ([]T, error) {
+	s, err := GetErr[[]any](source, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return deepSliceAppend([]T{}, "", s)
+}
+
+// deepMapInto adds the converted leaves beneath the given value into dst, keyed by their path
+func deepMapInto[T any](dst map[string]T, path string, value any) error {
+	switch v := value.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if err := deepMapInto(dst, joinPath(path, k), e); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for i, e := range v {
+			if err := deepMapInto(dst, joinPath(path, strconv.Itoa(i)), e); err != nil {
+				return err
+			}
+		}
+	default:
+		result, err := asType[T](value)
+		if err != nil {
+			return fmt.Errorf("path '%s': %w", path, err)
+		}
+		dst[path] = result
+	}
+
+	return nil
+}
+
+// deepSliceAppend appends the converted leaves of a slice to dst, flattening nested slices
+func deepSliceAppend[T any](dst []T, path string, in []any) ([]T, error) {
+	for i, e := range in {
+		elementPath := joinPath(path, strconv.Itoa(i))
+		if nested, ok := e.([]any); ok {
+			var err error
+			if dst, err = deepSliceAppend(dst, elementPath, nested); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		result, err := asType[T](e)
+		if err != nil {
+			return nil, fmt.Errorf("path '%s': %w", elementPath, err)
+		}
+		dst = append(dst, result)
+	}
+
+	return dst, nil
+}
@@ -0,0 +1,60 @@
+package mapreader
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDeepMap(t *testing.T) {
+	source := map[string]any{
+		"config": map[string]any{
+			"db":      map[string]any{"host": "localhost", "replicas": []any{"a", "b"}},
+			"name":    "app",
+			"version": map[string]any{"1.0": "stable"},
+			"empty":   map[string]any{},
+		},
+		"mixed": map[string]any{"name": "app", "port": 80},
+	}
+
+	result, err := DeepMap[string](source, "config")
+	expected := map[string]string{
+		"db.host":       "localhost",
+		"db.replicas.0": "a",
+		"db.replicas.1": "b",
+		"name":          "app",
+		`version.1\.0`:  "stable",
+	}
+	if err != nil || !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected: %v but got: %v (%v)", expected, result, err)
+	}
+
+	for path, value := range result {
+		if found, err := StrErr(source, "config."+path); err != nil || found != value {
+			t.Errorf("Expected: %s at config.%s but got: %s (%v)", value, path, found, err)
+		}
+	}
+
+	if _, err := DeepMap[string](source, "mixed"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	if _, err := DeepMap[string](source, "missing"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected error: %v, but got: %v", ErrKeyNotFound, err)
+	}
+}
+
+func TestDeepSlice(t *testing.T) {
+	source := map[string]any{
+		"matrix": []any{[]any{1.0, 2.0}, []any{}, []any{3.0, []any{4.0}}},
+		"mixed":  []any{[]any{1.0}, "two"},
+	}
+
+	if result, err := DeepSlice[float64](source, "matrix"); err != nil || !reflect.DeepEqual(result, []float64{1, 2, 3, 4}) {
+		t.Errorf("Expected: [1 2 3 4] but got: %v (%v)", result, err)
+	}
+
+	if _, err := DeepSlice[float64](source, "mixed"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+}
@@ -0,0 +1,99 @@
+package mapreader
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Duration returns the duration value found at the given lookup path, ignoring any errors
+//
+// If any error is encountered, it returns zero.
+// Use mapreader.DurationErr if you would like errors to be returned
+func Duration(source map[string]any, path string) time.Duration {
+	return withoutError(DurationErr(source, path))
+}
+
+// DurationDefault returns the duration value found at the given lookup path, or the default value
+//
+// The default is only returned for values that would otherwise error/aren't set.
+func DurationDefault(source map[string]any, path string, d time.Duration) time.Duration {
+	result, err := DurationErr(source, path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// DurationDefaultFunc returns the duration value found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func DurationDefaultFunc(source map[string]any, path string, d func() time.Duration) time.Duration {
+	result, err := DurationErr(source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// DurationDefaultOK returns the duration value found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func DurationDefaultOK(source map[string]any, path string, d time.Duration) (time.Duration, bool) {
+	result, err := DurationErr(source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// DurationErr returns the duration value found at the given lookup path, or returns an error
+//
+// Use mapreader.Duration if you would like to ignore errors
+// Strings are parsed by time.ParseDuration (e.g. "1h30m"), while numbers are counted in the unit set by
+// mapreader.WithDurationUnit, nanoseconds by default. Fractional numbers are accepted where they're a whole
+// number of nanoseconds, so with a unit of time.Second, 1.5 is returned as 1.5s.
+func DurationErr(source map[string]any, path string) (time.Duration, error) {
+	return get(source, path, &defaultOptions, asDuration(defaultOptions.DurationUnit))
+}
+
+// asDuration converts a duration string, or a number counted in the given unit, into a time.Duration
+func asDuration(unit time.Duration) func(any) (time.Duration, error) {
+	if unit <= 0 {
+		unit = time.Nanosecond
+	}
+
+	return func(in any) (time.Duration, error) {
+		switch v := in.(type) {
+		case time.Duration:
+			return v, nil
+		case string:
+			d, err := time.ParseDuration(strings.TrimSpace(v))
+			if err != nil {
+				return 0, fmt.Errorf("%w: %w", ErrUnableToConvert, err)
+			}
+
+			return d, nil
+		}
+
+		n, err := asNumberType[int64](in)
+		if err == nil {
+			if d := time.Duration(n) * unit; d/unit == time.Duration(n) {
+				return d, nil
+			}
+
+			return 0, fmt.Errorf("%w: %d%s overflows a time.Duration", ErrUnableToConvert, n, unit)
+		}
+
+		if !errors.Is(err, ErrUnableToConvert) {
+			return 0, fmt.Errorf("%w: expected a duration string or number, got %T", ErrUnexpectedType, in)
+		}
+
+		f, err := asNumberType[float64](in)
+		if err != nil {
+			return 0, err
+		}
+
+		return convertNumber[time.Duration](f * float64(unit))
+	}
+}
@@ -0,0 +1,64 @@
+package mapreader
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDurationErr(t *testing.T) {
+	source := map[string]any{
+		"string":   "1h30m",
+		"padded":   " 250ms ",
+		"duration": 3 * time.Second,
+		"int":      int64(1500),
+		"float":    float64(1500),
+		"fraction": 1.5,
+		"invalid":  "soon",
+		"bool":     true,
+		"huge":     float64(1e30),
+	}
+
+	tests := map[string]struct {
+		path        string
+		opts        []Option
+		expected    time.Duration
+		expectedErr error
+	}{
+		"Duration string":         {path: "string", expected: 90 * time.Minute},
+		"Padded string":           {path: "padded", expected: 250 * time.Millisecond},
+		"Duration value":          {path: "duration", expected: 3 * time.Second},
+		"Integer nanoseconds":     {path: "int", expected: 1500},
+		"Float nanoseconds":       {path: "float", expected: 1500},
+		"Integer milliseconds":    {path: "int", opts: []Option{WithDurationUnit(time.Millisecond)}, expected: 1500 * time.Millisecond},
+		"Float seconds":           {path: "fraction", opts: []Option{WithDurationUnit(time.Second)}, expected: 1500 * time.Millisecond},
+		"Fractional nanoseconds":  {path: "fraction", expectedErr: ErrUnableToConvert},
+		"Invalid string":          {path: "invalid", expectedErr: ErrUnableToConvert},
+		"Overflow":                {path: "int", opts: []Option{WithDurationUnit(1 << 60)}, expectedErr: ErrUnableToConvert},
+		"Float overflow":          {path: "huge", expectedErr: ErrUnableToConvert},
+		"Unsupported type":        {path: "bool", expectedErr: ErrUnexpectedType},
+		"Missing key":             {path: "missing", expectedErr: ErrKeyNotFound},
+		"Strings ignore the unit": {path: "string", opts: []Option{WithDurationUnit(time.Second)}, expected: 90 * time.Minute},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := New(source, tc.opts...).DurationErr(tc.path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+
+	if d := Duration(source, "string"); d != 90*time.Minute {
+		t.Errorf("Expected: 1h30m0s but got: %v", d)
+	}
+
+	if d := DurationDefault(source, "missing", time.Minute); d != time.Minute {
+		t.Errorf("Expected: 1m0s but got: %v", d)
+	}
+}
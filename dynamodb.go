@@ -0,0 +1,187 @@
+package mapreader
+
+import (
+	"encoding/base64"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// attributeValue unwraps a single DynamoDB attribute value into its plain Go equivalent
+//
+// Both the JSON shape (e.g. {"S": "x"}) and the AWS SDK v2 member types (e.g. *types.AttributeValueMemberS)
+// are recognised. Numbers become int64 or float64, binary values become []byte, and sets become []any.
+// Nested maps and lists are not unwrapped, see plainAttributeValue for that.
+func attributeValue(v any) (any, bool) {
+	tag, value, ok := attributeValueParts(v)
+	if !ok {
+		return nil, false
+	}
+
+	switch tag {
+	case "S", "M", "L", "BOOL":
+		return value, true
+	case "NULL":
+		return nil, true
+	case "N":
+		s, ok := value.(string)
+		if !ok {
+			return nil, false
+		}
+
+		return parseAttributeNumber(s), true
+	case "B":
+		return attributeBytes(value)
+	case "SS", "NS", "BS":
+		set := reflect.ValueOf(value)
+		if set.Kind() != reflect.Slice {
+			return nil, false
+		}
+
+		result := make([]any, set.Len())
+		for i := range result {
+			elem := set.Index(i).Interface()
+			switch tag {
+			case "NS":
+				s, ok := elem.(string)
+				if !ok {
+					return nil, false
+				}
+				result[i] = parseAttributeNumber(s)
+			case "BS":
+				if result[i], ok = attributeBytes(elem); !ok {
+					return nil, false
+				}
+			default:
+				result[i] = elem
+			}
+		}
+
+		return result, true
+	default:
+		return nil, false
+	}
+}
+
+// attributeValueParts splits a DynamoDB attribute value into its type tag and value
+func attributeValueParts(v any) (string, any, bool) {
+	if m, ok := v.(map[string]any); ok {
+		if len(m) != 1 {
+			return "", nil, false
+		}
+
+		for tag, value := range m {
+			switch tag {
+			case "S", "N", "B", "BOOL", "NULL", "M", "L", "SS", "NS", "BS":
+				return tag, value, true
+			}
+		}
+
+		return "", nil, false
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return "", nil, false
+	}
+
+	tag, ok := strings.CutPrefix(rv.Type().Name(), "AttributeValueMember")
+	if !ok {
+		return "", nil, false
+	}
+
+	value := rv.FieldByName("Value")
+	if !value.IsValid() || !value.CanInterface() {
+		return "", nil, false
+	}
+
+	return tag, value.Interface(), true
+}
+
+// attributeBytes converts a DynamoDB binary value, base64 decoding it when in the JSON shape
+func attributeBytes(v any) (any, bool) {
+	switch b := v.(type) {
+	case []byte:
+		return b, true
+	case string:
+		decoded, err := base64.StdEncoding.DecodeString(b)
+		if err != nil {
+			return nil, false
+		}
+
+		return decoded, true
+	default:
+		return nil, false
+	}
+}
+
+// parseAttributeNumber parses a DynamoDB number, returning an int64 where possible and a float64 otherwise
+//
+// Numbers that can't be parsed at all are returned as the original string.
+func parseAttributeNumber(s string) any {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i
+	}
+
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f
+	}
+
+	return s
+}
+
+// plainAttributeValue recursively unwraps DynamoDB attribute values, including those nested in maps and lists
+func plainAttributeValue(v any) any {
+	if unwrapped, ok := attributeValue(v); ok {
+		v = unwrapped
+	}
+
+	switch c := v.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(c))
+		for k, e := range c {
+			result[k] = plainAttributeValue(e)
+		}
+
+		return result
+	case []any:
+		result := make([]any, len(c))
+		for i, e := range c {
+			result[i] = plainAttributeValue(e)
+		}
+
+		return result
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return v
+		}
+
+		result := make(map[string]any, rv.Len())
+		for iter := rv.MapRange(); iter.Next(); {
+			result[iter.Key().String()] = plainAttributeValue(iter.Value().Interface())
+		}
+
+		return result
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() != reflect.Interface {
+			return v
+		}
+
+		result := make([]any, rv.Len())
+		for i := range result {
+			result[i] = plainAttributeValue(rv.Index(i).Interface())
+		}
+
+		return result
+	default:
+		return v
+	}
+}
@@ -0,0 +1,116 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// The following mimic the member types of the AWS SDK v2 DynamoDB types package
+
+type testAttributeValue interface{}
+
+type AttributeValueMemberS struct {
+	Value string
+}
+
+type AttributeValueMemberN struct {
+	Value string
+}
+
+type AttributeValueMemberM struct {
+	Value map[string]testAttributeValue
+}
+
+type AttributeValueMemberL struct {
+	Value []testAttributeValue
+}
+
+func TestReaderAttributeValueUnwrap(t *testing.T) {
+	source := map[string]any{}
+	err := json.Unmarshal([]byte(`{
+		"id": {"S": "order-1"},
+		"order": {"M": {
+			"total": {"N": "42"},
+			"ratio": {"N": "0.5"},
+			"paid": {"BOOL": true},
+			"note": {"NULL": true},
+			"items": {"L": [{"M": {"sku": {"S": "a1"}}}, {"M": {"sku": {"S": "b2"}}}]},
+			"tags": {"SS": ["new", "gift"]},
+			"blob": {"B": "aGVsbG8="}
+		}}
+	}`), &source)
+	if err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	r := New(source, WithAttributeValueUnwrap())
+
+	if result, err := r.StrErr("id"); err != nil || result != "order-1" {
+		t.Errorf("Expected: order-1 but got: %s (%v)", result, err)
+	}
+
+	if result, err := r.IntErr("order.total"); err != nil || result != 42 {
+		t.Errorf("Expected: 42 but got: %d (%v)", result, err)
+	}
+
+	if result, err := r.Float64Err("order.ratio"); err != nil || result != 0.5 {
+		t.Errorf("Expected: 0.5 but got: %v (%v)", result, err)
+	}
+
+	if result, err := r.BoolErr("order.paid"); err != nil || !result {
+		t.Errorf("Expected: true but got: %v (%v)", result, err)
+	}
+
+	if _, err := r.StrErr("order.note"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	if result, err := r.StrErr("order.items.1.sku"); err != nil || result != "b2" {
+		t.Errorf("Expected: b2 but got: %s (%v)", result, err)
+	}
+
+	if result, err := r.BytesErr("order.blob"); err != nil || string(result) != "hello" {
+		t.Errorf("Expected: hello but got: %s (%v)", result, err)
+	}
+
+	expectedItems := []any{map[string]any{"sku": "a1"}, map[string]any{"sku": "b2"}}
+	if result, err := ReadErr[[]any](r, "order.items"); err != nil || !reflect.DeepEqual(result, expectedItems) {
+		t.Errorf("Expected: %v but got: %v (%v)", expectedItems, result, err)
+	}
+
+	if result, err := ReadErr[[]any](r, "order.tags"); err != nil || !reflect.DeepEqual(result, []any{"new", "gift"}) {
+		t.Errorf("Expected: [new gift] but got: %v (%v)", result, err)
+	}
+
+	if _, err := StrErr(source, "order.total"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Package level lookups shouldn't unwrap attribute values, but got: %v", err)
+	}
+}
+
+func TestReaderAttributeValueUnwrapSDKTypes(t *testing.T) {
+	source := map[string]any{
+		"order": &AttributeValueMemberM{Value: map[string]testAttributeValue{
+			"total": &AttributeValueMemberN{Value: "12"},
+			"items": &AttributeValueMemberL{Value: []testAttributeValue{
+				&AttributeValueMemberS{Value: "a1"},
+			}},
+		}},
+	}
+
+	r := New(source, WithAttributeValueUnwrap())
+
+	if result, err := r.IntErr("order.total"); err != nil || result != 12 {
+		t.Errorf("Expected: 12 but got: %d (%v)", result, err)
+	}
+
+	if result, err := r.StrErr("order.items.0"); err != nil || result != "a1" {
+		t.Errorf("Expected: a1 but got: %s (%v)", result, err)
+	}
+
+	expected := map[string]any{"total": int64(12), "items": []any{"a1"}}
+	if result, err := ReadErr[map[string]any](r, "order"); err != nil || !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected: %v but got: %v (%v)", expected, result, err)
+	}
+}
@@ -0,0 +1,93 @@
+//go:build go1.23
+
+package mapreader
+
+import (
+	"fmt"
+	"iter"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Entries returns an iterator over the keys and values of the map found at the given lookup path, in key order
+//
+// The values are yielded as they are, so the map isn't converted or copied before the iteration begins,
+// even when it has typed values such as map[string]string. If the map can't be found, or the value isn't a map, the iterator yields nothing.
+func Entries(source map[string]any, path string) iter.Seq2[string, any] {
+	return entries(source, path, &defaultOptions)
+}
+
+// Elements returns an iterator over the indexes and values of the slice found at the given lookup path
+//
+// The values are yielded as they are, so the slice isn't converted or copied before the iteration begins,
+// even when it has typed elements such as []string. If the slice can't be found, or the value isn't a slice, the iterator yields nothing.
+func Elements(source map[string]any, path string) iter.Seq2[int, any] {
+	return elements(source, path, &defaultOptions)
+}
+
+// entries iterates over the keys and values of the map found at the given path, in key order
+func entries(source map[string]any, path string, opts *Options) iter.Seq2[string, any] {
+	return func(yield func(string, any) bool) {
+		m, err := get(source, path, opts, asReflectMap)
+		if err != nil {
+			return
+		}
+
+		keys := m.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int { return strings.Compare(a.String(), b.String()) })
+		for _, k := range keys {
+			if !yield(k.String(), m.MapIndex(k).Interface()) {
+				return
+			}
+		}
+	}
+}
+
+// elements iterates over the indexes and values of the slice found at the given path
+func elements(source map[string]any, path string, opts *Options) iter.Seq2[int, any] {
+	return func(yield func(int, any) bool) {
+		s, err := get(source, path, opts, asReflectSlice)
+		if err != nil {
+			return
+		}
+
+		for i := 0; i < s.Len(); i++ {
+			if !yield(i, s.Index(i).Interface()) {
+				return
+			}
+		}
+	}
+}
+
+// asReflectMap returns a map with string keys as a reflect.Value, so it can be iterated without being copied
+func asReflectMap(value any) (reflect.Value, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		return reflect.Value{}, fmt.Errorf("%w: expected a map but got '%T'", ErrUnexpectedType, value)
+	}
+
+	return v, nil
+}
+
+// asReflectSlice returns a slice or array as a reflect.Value, so it can be iterated without being copied
+//
+// As with the other slice getters, []byte values aren't treated as slices.
+func asReflectSlice(value any) (reflect.Value, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array || v.Type().Elem().Kind() == reflect.Uint8 {
+		return reflect.Value{}, fmt.Errorf("%w: expected a slice but got '%T'", ErrUnexpectedType, value)
+	}
+
+	return v, nil
+}
+
+// Elements is the Reader equivalent of mapreader.Elements
+func (r *Reader) Elements(path string) iter.Seq2[int, any] {
+	return elements(r.source, path, &r.opts)
+}
+
+// Entries is the Reader equivalent of mapreader.Entries
+func (r *Reader) Entries(path string) iter.Seq2[string, any] {
+	return entries(r.source, path, &r.opts)
+}
@@ -0,0 +1,108 @@
+//go:build go1.23
+
+package mapreader
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEntries(t *testing.T) {
+	source := map[string]any{
+		"users": map[string]any{"u2": "Jo", "u1": "Dan", "u3": "Sam"},
+		"items": []any{"a", "b", "c"},
+		"name":  "Dan",
+	}
+
+	var keys []string
+	var values []any
+	for k, v := range Entries(source, "users") {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+
+	if !reflect.DeepEqual(keys, []string{"u1", "u2", "u3"}) || !reflect.DeepEqual(values, []any{"Dan", "Jo", "Sam"}) {
+		t.Errorf("Unexpected entries: %v, %v", keys, values)
+	}
+
+	for k := range New(source).Entries("users") {
+		if k != "u1" {
+			t.Errorf("Expected: u1 but got: %v", k)
+		}
+		break
+	}
+
+	for range Entries(source, "items") {
+		t.Errorf("Expected no entries for a slice")
+	}
+
+	for range Entries(source, "missing") {
+		t.Errorf("Expected no entries for a missing key")
+	}
+}
+
+func TestElements(t *testing.T) {
+	source := map[string]any{
+		"items": []any{"a", "b", "c"},
+		"typed": []string{"x"},
+		"name":  "Dan",
+	}
+
+	var indexes []int
+	var values []any
+	for i, v := range Elements(source, "items") {
+		if i == 2 {
+			break
+		}
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+
+	if !reflect.DeepEqual(indexes, []int{0, 1}) || !reflect.DeepEqual(values, []any{"a", "b"}) {
+		t.Errorf("Unexpected elements: %v, %v", indexes, values)
+	}
+
+	for i, v := range New(source).Elements("typed") {
+		if i != 0 || v != "x" {
+			t.Errorf("Expected: 0 x but got: %v %v", i, v)
+		}
+	}
+
+	for range Elements(source, "name") {
+		t.Errorf("Expected no elements for a string")
+	}
+}
+
+func TestEntriesTypedContainers(t *testing.T) {
+	counts := map[string]int{"b": 2, "a": 1}
+	tags := []string{"x", "y"}
+	source := map[string]any{"counts": counts, "tags": tags}
+
+	var values []any
+	for k, v := range Entries(source, "counts") {
+		if k == "a" {
+			counts["b"] = 20
+		}
+		values = append(values, v)
+	}
+
+	if !reflect.DeepEqual(values, []any{1, 20}) {
+		t.Errorf("Expected typed maps to be iterated in place, but got: %v", values)
+	}
+
+	values = nil
+	for i, v := range Elements(source, "tags") {
+		if i == 0 {
+			tags[1] = "z"
+		}
+		values = append(values, v)
+	}
+
+	if !reflect.DeepEqual(values, []any{"x", "z"}) {
+		t.Errorf("Expected typed slices to be iterated in place, but got: %v", values)
+	}
+
+	for range Elements(map[string]any{"raw": []byte("ab")}, "raw") {
+		t.Errorf("Expected no elements for a []byte")
+	}
+}
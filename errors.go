@@ -0,0 +1,56 @@
+package mapreader
+
+import "errors"
+
+// ErrorInfo describes a failed lookup, for use by an error formatter
+type ErrorInfo struct {
+	// Path is the lookup path that failed.
+	Path string
+
+	// Kind is the package sentinel error describing the failure (e.g. mapreader.ErrKeyNotFound), or nil if there isn't one.
+	Kind error
+
+	// Err is the original error, including its default message.
+	Err error
+}
+
+// formattedError replaces the message of an error, while still matching (and unwrapping to) the original
+type formattedError struct {
+	message string
+	err     error
+}
+
+func (e *formattedError) Error() string {
+	return e.message
+}
+
+func (e *formattedError) Unwrap() error {
+	return e.err
+}
+
+// errorKinds are the sentinel errors reported as the kind of a failure
+var errorKinds = []error{
+	ErrEndOfNestedStructures,
+	ErrIndexOutOfBounds,
+	ErrInvalidDestination,
+	ErrInvalidPath,
+	ErrKeyNotFound,
+	ErrNilValue,
+	ErrNonIntegerSliceAccess,
+	ErrUnableToConvert,
+	ErrUnexpectedType,
+	ErrUnknownFormat,
+}
+
+// formatError applies an error formatter to the error returned from a failed lookup of the given path
+func formatError(path string, err error, formatter func(ErrorInfo) string) error {
+	info := ErrorInfo{Path: path, Err: err}
+	for _, kind := range errorKinds {
+		if errors.Is(err, kind) {
+			info.Kind = kind
+			break
+		}
+	}
+
+	return &formattedError{message: formatter(info), err: err}
+}
@@ -0,0 +1,51 @@
+package mapreader
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWithErrorFormatter(t *testing.T) {
+	source := map[string]any{"user": map[string]any{"name": "Dan", "age": "forty"}}
+
+	messages := map[error]string{
+		ErrKeyNotFound:    "ist nicht vorhanden",
+		ErrUnexpectedType: "hat den falschen Typ",
+	}
+
+	r := New(source, WithErrorFormatter(func(info ErrorInfo) string {
+		return info.Path + " " + messages[info.Kind]
+	}))
+
+	_, err := r.StrErr("user.email")
+	if err == nil || err.Error() != "user.email ist nicht vorhanden" {
+		t.Errorf("Expected: user.email ist nicht vorhanden but got: %v", err)
+	}
+
+	if !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected error: %v, but got: %v", ErrKeyNotFound, err)
+	}
+
+	_, err = r.IntErr("user.age")
+	if err == nil || err.Error() != "user.age hat den falschen Typ" || !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected: user.age hat den falschen Typ but got: %v", err)
+	}
+
+	if result, err := r.StrErr("user.name"); err != nil || result != "Dan" {
+		t.Errorf("Expected: Dan but got: %s (%v)", result, err)
+	}
+
+	var info ErrorInfo
+	r = New(source, WithErrorFormatter(func(i ErrorInfo) string {
+		info = i
+		return "failed"
+	}))
+	_, err = r.StrErr("user.name.first.x")
+	if info.Kind != ErrEndOfNestedStructures || info.Path != "user.name.first.x" || !errors.Is(info.Err, ErrEndOfNestedStructures) {
+		t.Errorf("Unexpected error info: %+v", info)
+	}
+
+	if !errors.Is(err, ErrEndOfNestedStructures) {
+		t.Errorf("Expected error: %v, but got: %v", ErrEndOfNestedStructures, err)
+	}
+}
@@ -0,0 +1,76 @@
+package mapreader
+
+// ApplyFieldMask returns a copy of the source containing only the fields selected by the mask
+//
+// This follows google.protobuf.FieldMask semantics over plain maps: each mask path selects a whole subtree,
+// so "a" takes precedence over "a.b", and masks beneath a list apply to every element of the list,
+// so "items.name" selects the name of each item. Paths to missing fields are ignored.
+// An empty mask selects the whole source, which is returned as is.
+func ApplyFieldMask(source map[string]any, mask []string) map[string]any {
+	if len(mask) == 0 {
+		return source
+	}
+
+	root := fieldMaskNode{}
+	for _, path := range mask {
+		root.add(splitPath(path))
+	}
+
+	result, _ := root.project(source).(map[string]any)
+	return result
+}
+
+// fieldMaskNode is a node of a trie of field mask paths, where a nil node selects its whole subtree
+type fieldMaskNode map[string]fieldMaskNode
+
+// add adds a path of segments beneath the node
+func (n fieldMaskNode) add(segments []string) {
+	key := segments[0]
+	child, exists := n[key]
+	switch {
+	case exists && child == nil:
+		// A parent path has already selected the whole subtree
+	case len(segments) == 1:
+		n[key] = nil
+	default:
+		if child == nil {
+			child = fieldMaskNode{}
+			n[key] = child
+		}
+
+		child.add(segments[1:])
+	}
+}
+
+// project returns the parts of the value selected by the node, or nil if nothing is selected
+func (n fieldMaskNode) project(value any) any {
+	if n == nil {
+		return value
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(n))
+		for key, child := range n {
+			field, ok := v[key]
+			if !ok {
+				continue
+			}
+
+			if projected := child.project(field); projected != nil || child == nil {
+				result[key] = projected
+			}
+		}
+
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, e := range v {
+			result[i] = n.project(e)
+		}
+
+		return result
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,53 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestApplyFieldMask(t *testing.T) {
+	source := map[string]any{}
+	err := json.Unmarshal([]byte(`{
+		"name": "order-1",
+		"total": 12.5,
+		"customer": {"name": "Dan", "email": "dan@example.com", "address": {"city": "Leeds", "zip": "LS1"}},
+		"items": [{"sku": "a", "qty": 1}, {"sku": "b", "qty": 2}],
+		"notes": null,
+		"dotted.key": "x"
+	}`), &source)
+	if err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	tests := map[string]struct {
+		mask     []string
+		expected string
+	}{
+		"Top level fields":     {mask: []string{"name", "total"}, expected: `{"name": "order-1", "total": 12.5}`},
+		"Nested fields":        {mask: []string{"customer.name", "customer.address.city"}, expected: `{"customer": {"name": "Dan", "address": {"city": "Leeds"}}}`},
+		"Parent wins":          {mask: []string{"customer.address.city", "customer", "customer.name"}, expected: `{"customer": {"name": "Dan", "email": "dan@example.com", "address": {"city": "Leeds", "zip": "LS1"}}}`},
+		"List elements":        {mask: []string{"items.sku"}, expected: `{"items": [{"sku": "a"}, {"sku": "b"}]}`},
+		"Missing fields":       {mask: []string{"missing", "customer.phone"}, expected: `{"customer": {}}`},
+		"Null values":          {mask: []string{"notes"}, expected: `{"notes": null}`},
+		"Masks beneath a leaf": {mask: []string{"name.first"}, expected: `{}`},
+		"Escaped keys":         {mask: []string{`dotted\.key`}, expected: `{"dotted.key": "x"}`},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			expected := map[string]any{}
+			if err := json.Unmarshal([]byte(tc.expected), &expected); err != nil {
+				t.Fatalf("Unable to unmarshal expected output: %s", err.Error())
+			}
+
+			if result := ApplyFieldMask(source, tc.mask); !reflect.DeepEqual(result, expected) {
+				t.Errorf("Expected: %v but got: %v", expected, result)
+			}
+		})
+	}
+
+	if result := ApplyFieldMask(source, nil); !reflect.DeepEqual(result, source) {
+		t.Errorf("Expected: the whole source but got: %v", result)
+	}
+}
@@ -0,0 +1,89 @@
+package mapreader
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The forms a flexible value can take, as reported by FlexibleError
+const (
+	FormNumber = "number"
+	FormString = "string"
+)
+
+// FlexibleError is returned when a value accepted in several forms fails to convert, identifying the form that failed
+//
+// It wraps the underlying conversion error, so errors.Is(err, mapreader.ErrUnableToConvert) still holds.
+type FlexibleError struct {
+	Form  string
+	Value any
+	Err   error
+}
+
+func (e *FlexibleError) Error() string {
+	return fmt.Sprintf("%s form %#v: %v", e.Form, e.Value, e.Err)
+}
+
+func (e *FlexibleError) Unwrap() error {
+	return e.Err
+}
+
+// Float64Flexible returns the numeric value found at the given lookup path as a float64, or returns an error
+//
+// The value may be either a number or a numeric string (e.g. 42 or "42").
+// Conversion failures are returned as a *FlexibleError identifying which form failed.
+func Float64Flexible(source map[string]any, path string) (float64, error) {
+	return get(source, path, &defaultOptions, asFlexibleNumber[float64])
+}
+
+// IntFlexible returns the numeric value found at the given lookup path as an int, or returns an error
+//
+// The value may be either a number or a numeric string (e.g. 42 or "42").
+// Conversion failures are returned as a *FlexibleError identifying which form failed.
+func IntFlexible(source map[string]any, path string) (int, error) {
+	return get(source, path, &defaultOptions, asFlexibleNumber[int])
+}
+
+// asFlexibleNumber converts either a number or a numeric string into the desired numeric type
+func asFlexibleNumber[R number](value any) (R, error) {
+	s, ok := value.(string)
+	if !ok {
+		result, err := asNumberType[R](value)
+		if errors.Is(err, ErrUnexpectedType) {
+			return result, fmt.Errorf("%w: expected a number or numeric string, got %T", ErrUnexpectedType, value)
+		}
+
+		if err != nil {
+			return result, &FlexibleError{Form: FormNumber, Value: value, Err: err}
+		}
+
+		return result, nil
+	}
+
+	result, err := parseNumber[R](strings.TrimSpace(s))
+	if err != nil {
+		return result, &FlexibleError{Form: FormString, Value: value, Err: err}
+	}
+
+	return result, nil
+}
+
+// parseNumber parses a decimal string into the desired numeric type, requiring the value to be equal once converted
+func parseNumber[R number](s string) (R, error) {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return convertNumber[R](i)
+	}
+
+	if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return convertNumber[R](u)
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q is not a number", ErrUnableToConvert, s)
+	}
+
+	return convertNumber[R](f)
+}
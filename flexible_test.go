@@ -0,0 +1,68 @@
+package mapreader
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNumberFlexible(t *testing.T) {
+	source := map[string]any{
+		"int":        42,
+		"float":      42.5,
+		"str":        "42",
+		"padded":     " 42 ",
+		"fractional": "42.5",
+		"word":       "forty-two",
+		"bool":       true,
+	}
+
+	tests := map[string]struct {
+		expectedInt   int
+		expectedFloat float64
+		intForm       string
+		floatForm     string
+	}{
+		"int":        {expectedInt: 42, expectedFloat: 42},
+		"float":      {expectedFloat: 42.5, intForm: FormNumber},
+		"str":        {expectedInt: 42, expectedFloat: 42},
+		"padded":     {expectedInt: 42, expectedFloat: 42},
+		"fractional": {expectedFloat: 42.5, intForm: FormString},
+		"word":       {intForm: FormString, floatForm: FormString},
+	}
+
+	for path, tc := range tests {
+		t.Run(path, func(t *testing.T) {
+			i, err := IntFlexible(source, path)
+			if form := flexibleForm(t, err); form != tc.intForm || i != tc.expectedInt {
+				t.Errorf("Expected: %d (%q) but got: %d (%v)", tc.expectedInt, tc.intForm, i, err)
+			}
+
+			f, err := Float64Flexible(source, path)
+			if form := flexibleForm(t, err); form != tc.floatForm || f != tc.expectedFloat {
+				t.Errorf("Expected: %v (%q) but got: %v (%v)", tc.expectedFloat, tc.floatForm, f, err)
+			}
+		})
+	}
+
+	if _, err := IntFlexible(source, "bool"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	if _, err := IntFlexible(source, "missing"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected error: %v, but got: %v", ErrKeyNotFound, err)
+	}
+}
+
+// flexibleForm returns the form reported by a FlexibleError, or the empty string for a nil error
+func flexibleForm(t *testing.T, err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var flexErr *FlexibleError
+	if !errors.As(err, &flexErr) {
+		t.Fatalf("Expected a FlexibleError, but got: %v", err)
+	}
+
+	return flexErr.Form
+}
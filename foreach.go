@@ -0,0 +1,67 @@
+package mapreader
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ForEach calls the function with each key and value of the map or slice found at the given lookup path, ignoring any errors
+//
+// If the map or slice can't be found, the function isn't called.
+// Use mapreader.ForEachErr if you would like errors to be returned
+func ForEach(source map[string]any, path string, fn func(key string, value any)) {
+	_ = ForEachErr(source, path, func(key string, value any) error {
+		fn(key, value)
+		return nil
+	})
+}
+
+// ForEachErr calls the function with each key and value of the map or slice found at the given lookup path,
+// or returns an error
+//
+// Maps are visited in key order, and slices in index order with their index formatted as the key.
+// Values are passed as they are, so the container isn't converted before it's visited.
+// Iteration stops at the first error returned by the function, which is returned annotated with its key,
+// and values other than maps and slices return ErrUnexpectedType.
+// Use mapreader.ForEach if you would like to ignore errors
+func ForEachErr(source map[string]any, path string, fn func(key string, value any) error) error {
+	return forEach(source, path, &defaultOptions, fn)
+}
+
+// forEach calls the function with each key and value of the map or slice found at the given path
+func forEach(source map[string]any, path string, opts *Options, fn func(key string, value any) error) error {
+	container, err := get(source, path, opts, asContainer)
+	if err != nil {
+		return err
+	}
+
+	switch c := container.(type) {
+	case map[string]any:
+		for _, k := range childKeys(c) {
+			if err := fn(k, c[k]); err != nil {
+				return fmt.Errorf("key '%s': %w", k, err)
+			}
+		}
+	case []any:
+		for i, v := range c {
+			if err := fn(strconv.Itoa(i), v); err != nil {
+				return fmt.Errorf("index %d: %w", i, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// asContainer converts a map with string keys into map[string]any, or a slice into []any
+func asContainer(value any) (any, error) {
+	if m, err := asType[map[string]any](value); err == nil {
+		return m, nil
+	}
+
+	if s, err := asType[[]any](value); err == nil {
+		return s, nil
+	}
+
+	return nil, fmt.Errorf("%w: expected a map or slice but got '%T'", ErrUnexpectedType, value)
+}
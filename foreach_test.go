@@ -0,0 +1,59 @@
+package mapreader
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestForEachErr(t *testing.T) {
+	source := map[string]any{
+		"users": map[string]any{"u2": "Jo", "u1": "Dan"},
+		"items": []any{"a", "b", "c"},
+		"typed": map[string]int{"x": 1},
+		"name":  "Dan",
+	}
+
+	var visited []string
+	err := ForEachErr(source, "users", func(key string, value any) error {
+		visited = append(visited, key+"="+value.(string))
+		return nil
+	})
+	if err != nil || !reflect.DeepEqual(visited, []string{"u1=Dan", "u2=Jo"}) {
+		t.Errorf("Unexpected visits: %v (%v)", visited, err)
+	}
+
+	errStop := errors.New("stop")
+	visited = nil
+	err = ForEachErr(source, "items", func(key string, value any) error {
+		visited = append(visited, key)
+		if key == "1" {
+			return errStop
+		}
+		return nil
+	})
+	if !errors.Is(err, errStop) || !strings.Contains(err.Error(), "index 1") {
+		t.Errorf("Expected error: %v at index 1, but got: %v", errStop, err)
+	}
+
+	if !reflect.DeepEqual(visited, []string{"0", "1"}) {
+		t.Errorf("Expected iteration to stop at index 1 but visited: %v", visited)
+	}
+
+	visited = nil
+	New(source).ForEach("typed", func(key string, value any) {
+		visited = append(visited, key)
+	})
+	if !reflect.DeepEqual(visited, []string{"x"}) {
+		t.Errorf("Expected: [x] but got: %v", visited)
+	}
+
+	if err := ForEachErr(source, "name", func(string, any) error { return nil }); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	if err := ForEachErr(source, "missing", func(string, any) error { return nil }); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected error: %v, but got: %v", ErrKeyNotFound, err)
+	}
+}
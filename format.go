@@ -0,0 +1,125 @@
+package mapreader
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// Formats recognised by mapreader.DetectFormat
+const (
+	FormatJSON = "json"
+	FormatTOML = "toml"
+	FormatYAML = "yaml"
+)
+
+var (
+	formatsMu sync.RWMutex
+	formats   = map[string]func([]byte, any) error{}
+)
+
+// RegisterFormat registers a decoder for the given format, for use by mapreader.FromBytes
+//
+// The decoder has the signature of the Unmarshal functions of the common YAML and TOML packages,
+// e.g. RegisterFormat(mapreader.FormatYAML, yaml.Unmarshal). JSON is supported without registration,
+// but registering a JSON decoder replaces the built in one.
+func RegisterFormat(format string, decode func(data []byte, v any) error) {
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+
+	formats[format] = decode
+}
+
+// FromBytes detects the format of the given document, and decodes it into a Reader
+//
+// JSON is decoded as by mapreader.FromJSON, while other formats require a decoder to be registered
+// with mapreader.RegisterFormat. Maps with non-string keys (as produced by some YAML decoders)
+// are normalised into map[string]any.
+func FromBytes(b []byte, opts ...Option) (*Reader, error) {
+	format := DetectFormat(b)
+
+	formatsMu.RLock()
+	decode, ok := formats[format]
+	formatsMu.RUnlock()
+
+	if !ok {
+		if format == FormatJSON {
+			return FromJSON(b, opts...)
+		}
+
+		return nil, fmt.Errorf("%w: no decoder registered for %s, see mapreader.RegisterFormat", ErrUnknownFormat, format)
+	}
+
+	var value any
+	if err := decode(b, &value); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnableToConvert, err)
+	}
+
+	source, ok := normaliseKeys(value).(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a %s document with a top level object, got %T", ErrUnexpectedType, format, value)
+	}
+
+	return New(source, opts...), nil
+}
+
+// DetectFormat sniffs whether a document is JSON, YAML or TOML
+//
+// Documents starting with '{', or '[' and valid as JSON, are JSON. Otherwise, the first line that isn't blank
+// or a comment decides: a table header or a key followed by '=' is TOML, and anything else is YAML.
+func DetectFormat(b []byte) string {
+	b = bytes.TrimPrefix(b, []byte("\xef\xbb\xbf"))
+	trimmed := bytes.TrimSpace(b)
+
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		return FormatJSON
+	case bytes.HasPrefix(trimmed, []byte("[")) && json.Valid(trimmed):
+		return FormatJSON
+	}
+
+	for _, line := range bytes.Split(trimmed, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		if line[0] == '[' {
+			return FormatTOML
+		}
+
+		eq := bytes.IndexByte(line, '=')
+		colon := bytes.IndexByte(line, ':')
+		if eq > 0 && (colon < 0 || eq < colon) {
+			return FormatTOML
+		}
+
+		return FormatYAML
+	}
+
+	return FormatYAML
+}
+
+// normaliseKeys converts maps with non-string keys within a decoded document into map[string]any
+func normaliseKeys(value any) any {
+	switch v := value.(type) {
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for k, e := range v {
+			m[fmt.Sprint(k)] = normaliseKeys(e)
+		}
+
+		return m
+	case map[string]any:
+		for k, e := range v {
+			v[k] = normaliseKeys(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = normaliseKeys(e)
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,73 @@
+package mapreader
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDetectFormat(t *testing.T) {
+	tests := map[string]string{
+		`{"a": 1}`:                        FormatJSON,
+		"\xef\xbb\xbf  [1, 2]":            FormatJSON,
+		"[server]\nport = 80":             FormatTOML,
+		"# comment\ntitle = \"x\"":        FormatTOML,
+		"url = \"http://example.com\"":    FormatTOML,
+		"---\nserver:\n  port: 80":        FormatYAML,
+		"# comment\n\nserver: {port: 80}": FormatYAML,
+		"- a\n- b":                        FormatYAML,
+		"message: a = b":                  FormatYAML,
+		"":                                FormatYAML,
+	}
+
+	for doc, expected := range tests {
+		if result := DetectFormat([]byte(doc)); result != expected {
+			t.Errorf("Expected: %s but got: %s (%q)", expected, result, doc)
+		}
+	}
+}
+
+func TestFromBytes(t *testing.T) {
+	r, err := FromBytes([]byte(`{"server": {"port": 8080}}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result, err := r.IntErr("server.port"); err != nil || result != 8080 {
+		t.Errorf("Expected: 8080 but got: %d (%v)", result, err)
+	}
+
+	if _, err := FromBytes([]byte("server:\n  port: 8080")); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnknownFormat, err)
+	}
+
+	RegisterFormat(FormatYAML, func(data []byte, v any) error {
+		if string(data) == "invalid" {
+			return errors.New("invalid document")
+		}
+
+		*(v.(*any)) = map[any]any{"server": map[any]any{"port": 8080, 1: []any{map[any]any{"name": "one"}}}}
+		return nil
+	})
+	t.Cleanup(func() {
+		formatsMu.Lock()
+		delete(formats, FormatYAML)
+		formatsMu.Unlock()
+	})
+
+	r, err = FromBytes([]byte("server:\n  port: 8080"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result, err := r.IntErr("server.port"); err != nil || result != 8080 {
+		t.Errorf("Expected: 8080 but got: %d (%v)", result, err)
+	}
+
+	if result, err := r.StrErr("server.1.0.name"); err != nil || result != "one" {
+		t.Errorf("Expected: one but got: %s (%v)", result, err)
+	}
+
+	if _, err := FromBytes([]byte("invalid")); !errors.Is(err, ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, err)
+	}
+}
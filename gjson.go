@@ -0,0 +1,23 @@
+package mapreader
+
+import "fmt"
+
+// GJSONResult is implemented by tidwall/gjson's Result type
+//
+// Any other type with a Value method returning decoded JSON will also work.
+type GJSONResult interface {
+	Value() any
+}
+
+// FromGJSON returns a Reader for the JSON object held by a gjson.Result, configured with any given options
+//
+// This allows values already located with gjson to be navigated using mapreader's typed getters.
+// An ErrUnexpectedType error is returned if the result doesn't hold an object.
+func FromGJSON(result GJSONResult, opts ...Option) (*Reader, error) {
+	source, ok := result.Value().(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a JSON object but got '%T'", ErrUnexpectedType, result.Value())
+	}
+
+	return New(source, opts...), nil
+}
@@ -0,0 +1,36 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type testGJSONResult struct {
+	raw string
+}
+
+func (r testGJSONResult) Value() any {
+	var value any
+	_ = json.Unmarshal([]byte(r.raw), &value)
+	return value
+}
+
+func TestFromGJSON(t *testing.T) {
+	r, err := FromGJSON(testGJSONResult{raw: `{"user": {"id": 42, "tags": ["a", "b"]}}`})
+	if err != nil {
+		t.Fatalf("FromGJSON should not return an error for an object, got: %v", err)
+	}
+
+	if result := r.Int("user.id"); result != 42 {
+		t.Errorf("Expected: 42 but got: %d", result)
+	}
+
+	if result := r.Str("user.tags.1"); result != "b" {
+		t.Errorf("Expected: b but got: %s", result)
+	}
+
+	if _, err := FromGJSON(testGJSONResult{raw: `[1, 2]`}); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+}
@@ -0,0 +1,105 @@
+package mapreader
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// GraphQLError is an entry from the errors list of a GraphQL response
+type GraphQLError struct {
+	Message    string
+	Path       []string
+	Extensions map[string]any
+}
+
+// Error returns the error message, along with the path of the error where it has one
+func (e *GraphQLError) Error() string {
+	if len(e.Path) == 0 {
+		return "graphql: " + e.Message
+	}
+
+	return fmt.Sprintf("graphql: %s (path: %s)", e.Message, strings.Join(e.Path, "."))
+}
+
+// FromGraphQL returns a Reader for the data of a GraphQL response, configured with any given options
+//
+// Lookups are rooted at the response's data, so "user.name" reads {"data": {"user": {"name": ...}}}.
+// When a lookup fails and one of the response's errors has a path overlapping the requested path,
+// that *GraphQLError is returned instead of the lookup error (such as ErrKeyNotFound or ErrUnexpectedType).
+// If the response has no data, the first GraphQL error is returned, or ErrUnexpectedType if there are none.
+func FromGraphQL(response map[string]any, opts ...Option) (*Reader, error) {
+	errs := GraphQLErrors(response)
+
+	data, ok := response["data"].(map[string]any)
+	if !ok {
+		if len(errs) > 0 {
+			return nil, errs[0]
+		}
+
+		return nil, fmt.Errorf("%w: expected a data object but got '%T'", ErrUnexpectedType, response["data"])
+	}
+
+	r := New(data, opts...)
+	r.opts.lookupError = func(path string, err error) error {
+		if gqlErr := overlappingGraphQLError(errs, splitPath(path)); gqlErr != nil {
+			return gqlErr
+		}
+
+		return err
+	}
+
+	return r, nil
+}
+
+// GraphQLErrors returns the errors list of a GraphQL response
+//
+// Path elements are converted to strings, so list indices match the equivalent lookup path segment.
+func GraphQLErrors(response map[string]any) []*GraphQLError {
+	entries, _ := response["errors"].([]any)
+
+	errs := make([]*GraphQLError, 0, len(entries))
+	for _, entry := range entries {
+		m, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		gqlErr := &GraphQLError{}
+		gqlErr.Message, _ = m["message"].(string)
+		gqlErr.Extensions, _ = m["extensions"].(map[string]any)
+
+		path, _ := m["path"].([]any)
+		for _, p := range path {
+			switch v := p.(type) {
+			case string:
+				gqlErr.Path = append(gqlErr.Path, v)
+			default:
+				if i, err := asNumberType[int](v); err == nil {
+					gqlErr.Path = append(gqlErr.Path, strconv.Itoa(i))
+				}
+			}
+		}
+
+		errs = append(errs, gqlErr)
+	}
+
+	return errs
+}
+
+// overlappingGraphQLError returns the first error whose path is a prefix of the keys, or which the keys are a prefix of
+func overlappingGraphQLError(errs []*GraphQLError, keys []string) *GraphQLError {
+	for _, gqlErr := range errs {
+		if len(gqlErr.Path) == 0 {
+			continue
+		}
+
+		n := min(len(gqlErr.Path), len(keys))
+		if slices.Equal(gqlErr.Path[:n], keys[:n]) {
+			return gqlErr
+		}
+	}
+
+	return nil
+}
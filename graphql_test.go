@@ -0,0 +1,69 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestFromGraphQL(t *testing.T) {
+	response := map[string]any{}
+	err := json.Unmarshal([]byte(`{
+		"data": {
+			"user": {
+				"name": "Jo",
+				"friends": [{"name": "Al"}, null],
+				"avatar": null
+			}
+		},
+		"errors": [
+			{"message": "friend unavailable", "path": ["user", "friends", 1]},
+			{"message": "avatar service down", "path": ["user", "avatar"], "extensions": {"code": "UNAVAILABLE"}}
+		]
+	}`), &response)
+	if err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	r, err := FromGraphQL(response)
+	if err != nil {
+		t.Fatalf("FromGraphQL should not return an error when there is data, got: %v", err)
+	}
+
+	if result, err := r.StrErr("user.name"); err != nil || result != "Jo" {
+		t.Errorf("Expected: Jo but got: %s (%v)", result, err)
+	}
+
+	if result, err := r.StrErr("user.friends.0.name"); err != nil || result != "Al" {
+		t.Errorf("Expected: Al but got: %s (%v)", result, err)
+	}
+
+	var gqlErr *GraphQLError
+	if _, err := r.StrErr("user.friends.1.name"); !errors.As(err, &gqlErr) || gqlErr.Message != "friend unavailable" {
+		t.Errorf("Expected the friend unavailable GraphQL error, but got: %v", err)
+	}
+
+	if _, err := r.StrErr("user.avatar"); !errors.As(err, &gqlErr) || gqlErr.Extensions["code"] != "UNAVAILABLE" {
+		t.Errorf("Expected the avatar GraphQL error, but got: %v", err)
+	}
+
+	if _, err := r.StrErr("user.email"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected error: %v, but got: %v", ErrKeyNotFound, err)
+	}
+}
+
+func TestFromGraphQLWithoutData(t *testing.T) {
+	response := map[string]any{}
+	if err := json.Unmarshal([]byte(`{"data": null, "errors": [{"message": "syntax error"}]}`), &response); err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	var gqlErr *GraphQLError
+	if _, err := FromGraphQL(response); !errors.As(err, &gqlErr) || gqlErr.Error() != "graphql: syntax error" {
+		t.Errorf("Expected the syntax error GraphQL error, but got: %v", err)
+	}
+
+	if _, err := FromGraphQL(map[string]any{}); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+}
@@ -0,0 +1,72 @@
+package mapreader
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// readerContextKey is the context key used to store a Reader by JSONBodyMiddleware
+type readerContextKey struct{}
+
+// ReadRequestJSON decodes a JSON object request body of at most maxBytes, returning a Reader for it
+//
+// If the body is too large, the returned error will wrap an *http.MaxBytesError, and w is told to close the connection
+// after the response, as with http.MaxBytesReader. The body must contain exactly one JSON object.
+func ReadRequestJSON(w http.ResponseWriter, r *http.Request, maxBytes int64, opts ...Option) (*Reader, error) {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBytes))
+
+	var source map[string]any
+	if err := dec.Decode(&source); err != nil {
+		return nil, fmt.Errorf("decoding request body: %w", err)
+	}
+
+	if source == nil {
+		return nil, fmt.Errorf("decoding request body: %w: expected a JSON object", ErrUnexpectedType)
+	}
+
+	if _, err := dec.Token(); err == nil {
+		return nil, errors.New("decoding request body: unexpected data after JSON object")
+	} else if !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("decoding request body: %w", err)
+	}
+
+	return New(source, opts...), nil
+}
+
+// JSONBodyMiddleware decodes JSON request bodies with ReadRequestJSON, storing the Reader in the request context
+//
+// Requests with a body that is too large are rejected with 413 Request Entity Too Large,
+// and those that can't otherwise be decoded with 400 Bad Request.
+// Use mapreader.FromContext to retrieve the Reader within the next handler.
+func JSONBodyMiddleware(maxBytes int64, next http.Handler, opts ...Option) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reader, err := ReadRequestJSON(w, r, maxBytes, opts...)
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), reader)))
+	})
+}
+
+// NewContext returns a copy of the context that carries the Reader
+func NewContext(ctx context.Context, r *Reader) context.Context {
+	return context.WithValue(ctx, readerContextKey{}, r)
+}
+
+// FromContext returns the Reader stored in the context, if there is one
+func FromContext(ctx context.Context) (*Reader, bool) {
+	r, ok := ctx.Value(readerContextKey{}).(*Reader)
+	return r, ok
+}
@@ -0,0 +1,124 @@
+package mapreader
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestReadRequestJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		maxBytes    int64
+		expectedErr bool
+	}{
+		{name: "Valid", body: `{"user": {"id": 7}}`, maxBytes: 1024},
+		{name: "Too large", body: `{"user": {"id": 7}}`, maxBytes: 4, expectedErr: true},
+		{name: "Not an object", body: `[1, 2]`, maxBytes: 1024, expectedErr: true},
+		{name: "Null", body: `null`, maxBytes: 1024, expectedErr: true},
+		{name: "Trailing data", body: `{"a": 1} {"b": 2}`, maxBytes: 1024, expectedErr: true},
+		{name: "Invalid", body: `{"a": `, maxBytes: 1024, expectedErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+			r, err := ReadRequestJSON(httptest.NewRecorder(), req, tc.maxBytes)
+			if tc.expectedErr {
+				if err == nil {
+					t.Error("Expected an error but got nil")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if result := r.Int("user.id"); result != 7 {
+				t.Errorf("Expected: 7 but got: %d", result)
+			}
+		})
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"a": "long value"}`))
+	var maxBytesErr *http.MaxBytesError
+	if _, err := ReadRequestJSON(httptest.NewRecorder(), req, 4); !errors.As(err, &maxBytesErr) {
+		t.Errorf("Expected a *http.MaxBytesError, but got: %v", err)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"a": 1}`+strings.Repeat(" ", 32)))
+	if _, err := ReadRequestJSON(httptest.NewRecorder(), req, 12); !errors.As(err, &maxBytesErr) {
+		t.Errorf("Expected a *http.MaxBytesError for oversized trailing whitespace, but got: %v", err)
+	}
+}
+
+func TestReadRequestJSONTooLarge(t *testing.T) {
+	var err error
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err = ReadRequestJSON(w, r, 4); err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		}
+	}))
+	defer srv.Close()
+
+	resp, postErr := http.Post(srv.URL, "application/json", strings.NewReader(`{"a": "long value"}`))
+	if postErr != nil {
+		t.Fatalf("Unexpected error: %v", postErr)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected status: %d but got: %d", http.StatusRequestEntityTooLarge, resp.StatusCode)
+	}
+
+	var maxBytesErr *http.MaxBytesError
+	if !errors.As(err, &maxBytesErr) || maxBytesErr.Limit != 4 {
+		t.Errorf("Expected a *http.MaxBytesError with a limit of 4, but got: %v", err)
+	}
+
+	if !resp.Close {
+		t.Error("Expected the server to close the connection after an oversized body")
+	}
+}
+
+func TestJSONBodyMiddleware(t *testing.T) {
+	handler := JSONBodyMiddleware(16, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reader, ok := FromContext(r.Context())
+		if !ok {
+			t.Error("Expected a Reader in the request context")
+			return
+		}
+		_, _ = w.Write([]byte(reader.Str("name")))
+	}))
+
+	tests := []struct {
+		body           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{body: `{"name": "jo"}`, expectedStatus: http.StatusOK, expectedBody: "jo"},
+		{body: `{"name": "a much longer name"}`, expectedStatus: http.StatusRequestEntityTooLarge},
+		{body: `"jo"`, expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tc := range tests {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body)))
+
+		if rec.Code != tc.expectedStatus {
+			t.Errorf("Expected status: %d but got: %d for body %s", tc.expectedStatus, rec.Code, tc.body)
+		}
+
+		if tc.expectedBody != "" && rec.Body.String() != tc.expectedBody {
+			t.Errorf("Expected body: %s but got: %s", tc.expectedBody, rec.Body.String())
+		}
+	}
+
+	if _, ok := FromContext(httptest.NewRequest(http.MethodGet, "/", nil).Context()); ok {
+		t.Error("FromContext should report false when no Reader is stored")
+	}
+}
@@ -0,0 +1,73 @@
+package mapreader
+
+import "fmt"
+
+// JMESPathExpression is implemented by a compiled jmespath-community/go-jmespath (or jmespath/go-jmespath) expression
+//
+// e.g. jmespath.MustCompile("locations[?state == 'WA'].name | sort(@)")
+// Any other type with a Search method evaluating an expression against decoded JSON will also work.
+type JMESPathExpression interface {
+	Search(data any) (any, error)
+}
+
+// JMESPathFunc adapts a search function, such as jmespath.Search, into a JMESPathExpression for the given expression
+type JMESPathFunc func(expression string, data any) (any, error)
+
+// Expression returns a JMESPathExpression evaluating the given expression with the search function
+func (fn JMESPathFunc) Expression(expression string) JMESPathExpression {
+	return jmesPathFuncExpression{fn: fn, expression: expression}
+}
+
+// jmesPathFuncExpression is a JMESPathExpression evaluated by a search function
+type jmesPathFuncExpression struct {
+	fn         JMESPathFunc
+	expression string
+}
+
+// Search evaluates the expression against the data
+func (e jmesPathFuncExpression) Search(data any) (any, error) {
+	return e.fn(e.expression, data)
+}
+
+// Search returns the result of evaluating a JMESPath expression against the source, ignoring any errors
+//
+// Use mapreader.SearchErr if you would like errors to be returned
+func Search[T any](source map[string]any, expr JMESPathExpression) T {
+	return withoutError(SearchErr[T](source, expr))
+}
+
+// SearchErr returns the result of evaluating a JMESPath expression against the source, or returns an error
+//
+// The result is converted in the same way as mapreader.GetErr, e.g. SearchErr[string](source, jmespath.MustCompile("people[0].name"))
+// Errors from evaluating the expression are returned as is.
+func SearchErr[T any](source map[string]any, expr JMESPathExpression) (T, error) {
+	return search[T](source, expr, &defaultOptions)
+}
+
+// FromJMESPath returns a Reader for the object resulting from a JMESPath expression, configured with any given options
+//
+// This allows the typed getters to be used on the result of a query, e.g. reading "name" from "people[?age > `20`] | [0]".
+// An ErrUnexpectedType error is returned if the expression doesn't result in an object.
+func FromJMESPath(source map[string]any, expr JMESPathExpression, opts ...Option) (*Reader, error) {
+	result, err := expr.Search(source)
+	if err != nil {
+		return nil, err
+	}
+
+	m, ok := result.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a JMESPath result object but got '%T'", ErrUnexpectedType, result)
+	}
+
+	return New(m, opts...), nil
+}
+
+// search evaluates a JMESPath expression against the source and converts the result into T
+func search[T any](source map[string]any, expr JMESPathExpression, opts *Options) (T, error) {
+	result, err := expr.Search(source)
+	if err != nil {
+		return *new(T), err
+	}
+
+	return convertValue(result, opts, asType[T])
+}
@@ -0,0 +1,75 @@
+package mapreader
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// testJMESPath stands in for a compiled JMESPath expression, returning a fixed result
+type testJMESPath struct {
+	result any
+	err    error
+}
+
+func (e testJMESPath) Search(data any) (any, error) {
+	return e.result, e.err
+}
+
+func TestSearchErr(t *testing.T) {
+	source := map[string]any{"people": []any{map[string]any{"name": "jo"}, map[string]any{"name": "dan"}}}
+	errSearch := errors.New("syntax error")
+
+	tests := map[string]struct {
+		expr        JMESPathExpression
+		expected    []any
+		expectedErr error
+	}{
+		"names":        {expr: testJMESPath{result: []any{"jo", "dan"}}, expected: []any{"jo", "dan"}},
+		"typed slice":  {expr: testJMESPath{result: []string{"jo"}}, expected: []any{"jo"}},
+		"search error": {expr: testJMESPath{err: errSearch}, expectedErr: errSearch},
+		"wrong type":   {expr: testJMESPath{result: "jo"}, expectedErr: ErrUnexpectedType},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := SearchErr[[]any](source, tc.expr)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestJMESPathFunc(t *testing.T) {
+	source := map[string]any{"people": []any{map[string]any{"name": "jo", "age": 42.0}}}
+
+	var searchFn JMESPathFunc = func(expression string, data any) (any, error) {
+		if expression != "people[0]" {
+			return nil, errors.New("unexpected expression")
+		}
+
+		return data.(map[string]any)["people"].([]any)[0], nil
+	}
+
+	r, err := FromJMESPath(source, searchFn.Expression("people[0]"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result := r.Int("age"); result != 42 {
+		t.Errorf("Expected: 42 but got: %d", result)
+	}
+
+	if _, err := FromJMESPath(source, testJMESPath{result: []any{}}); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	if result := Search[string](source, searchFn.Expression("other")); result != "" {
+		t.Errorf("Expected an empty result but got: %s", result)
+	}
+}
@@ -0,0 +1,35 @@
+package mapreader
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// FromJSON decodes a JSON object into a Reader, preserving integers as int64
+//
+// Numbers without a fraction or exponent that fit into an int64 are decoded as int64, with all other numbers
+// decoded as float64. This avoids the loss of precision of large integers (e.g. IDs) decoded as float64.
+// When built with encoding/json/v2 available (GOEXPERIMENT=jsonv2), decoding uses encoding/json/jsontext,
+// which also rejects invalid UTF-8 and duplicate object keys.
+func FromJSON(data []byte, opts ...Option) (*Reader, error) {
+	value, err := decodeJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnableToConvert, err)
+	}
+
+	source, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a JSON object, got %T", ErrUnexpectedType, value)
+	}
+
+	return New(source, opts...), nil
+}
+
+// parseJSONNumber parses the text of a JSON number, as an int64 if it's an integer in range, otherwise as a float64
+func parseJSONNumber(s string) (any, error) {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i, nil
+	}
+
+	return strconv.ParseFloat(s, 64)
+}
@@ -0,0 +1,49 @@
+package mapreader
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFromJSON(t *testing.T) {
+	r, err := FromJSON([]byte(`{"id": 9007199254740993, "ratio": 0.5, "big": 1e3, "tags": ["a", 1], "ok": true, "none": null}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result, err := ReadErr[int64](r, "id"); err != nil || result != 9007199254740993 {
+		t.Errorf("Expected: 9007199254740993 but got: %d (%v)", result, err)
+	}
+
+	if result, err := ReadErr[float64](r, "ratio"); err != nil || result != 0.5 {
+		t.Errorf("Expected: 0.5 but got: %v (%v)", result, err)
+	}
+
+	if result, err := ReadErr[float64](r, "big"); err != nil || result != 1000 {
+		t.Errorf("Expected: 1000 but got: %v (%v)", result, err)
+	}
+
+	if result, err := ReadErr[int64](r, "tags.1"); err != nil || result != 1 {
+		t.Errorf("Expected: 1 but got: %v (%v)", result, err)
+	}
+
+	if result, err := r.BoolErr("ok"); err != nil || !result {
+		t.Errorf("Expected: true but got: %v (%v)", result, err)
+	}
+
+	if result, present, isNull, err := ReadNullable[string](r, "none"); err != nil || !present || !isNull {
+		t.Errorf("Expected: (, true, true) but got: (%s, %t, %t, %v)", result, present, isNull, err)
+	}
+
+	if _, err := FromJSON([]byte(`[1, 2]`)); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	if _, err := FromJSON([]byte(`{"a": 1} {"b": 2}`)); !errors.Is(err, ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, err)
+	}
+
+	if _, err := FromJSON([]byte(`{"a": `)); !errors.Is(err, ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, err)
+	}
+}
@@ -0,0 +1,124 @@
+package mapreader
+
+// FromJSONAPI returns a Reader for a JSON:API document that follows relationships through the included resources
+//
+// A relationship is resolved to its included resource when the path continues beyond it,
+// so "data.relationships.author.name" reads the name attribute of the included author.
+// The attributes and relationships of a resource can also be read directly from the resource,
+// so the same value can be read from "data.author.name". To-many relationships resolve to a list of resources.
+// Resources that aren't part of the document can't be followed, and return ErrKeyNotFound.
+func FromJSONAPI(document map[string]any, opts ...Option) *Reader {
+	index := make(map[[2]string]map[string]any)
+	addResources := func(v any) {
+		resources, ok := v.([]any)
+		if !ok {
+			resources = []any{v}
+		}
+
+		for _, r := range resources {
+			if resource, ok := r.(map[string]any); ok {
+				if key, ok := jsonAPIKey(resource); ok {
+					index[key] = resource
+				}
+			}
+		}
+	}
+	addResources(document["data"])
+	addResources(document["included"])
+
+	return New(document, append(opts, WithUnwrapper(func(v any) (any, bool) {
+		return jsonAPIValue(v, index)
+	}))...)
+}
+
+// jsonAPIValue unwraps JSON:API relationships into the resources they refer to, and resources into their fields
+func jsonAPIValue(v any, index map[[2]string]map[string]any) (any, bool) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+
+	if linkage, ok := jsonAPILinkage(m); ok {
+		switch l := linkage.(type) {
+		case map[string]any:
+			key, _ := jsonAPIKey(l)
+			resource, ok := index[key]
+			return resource, ok
+		case []any:
+			resources := make([]any, 0, len(l))
+			for _, identifier := range l {
+				if i, ok := identifier.(map[string]any); ok {
+					key, _ := jsonAPIKey(i)
+					if resource, ok := index[key]; ok {
+						resources = append(resources, resource)
+					}
+				}
+			}
+
+			return resources, true
+		default:
+			return nil, false
+		}
+	}
+
+	if _, ok := jsonAPIKey(m); !ok {
+		return nil, false
+	}
+
+	attributes, _ := m["attributes"].(map[string]any)
+	relationships, _ := m["relationships"].(map[string]any)
+
+	fields := make(map[string]any, len(attributes)+len(relationships))
+	for k, v := range attributes {
+		fields[k] = v
+	}
+	for k, v := range relationships {
+		fields[k] = v
+	}
+
+	return fields, true
+}
+
+// jsonAPIKey returns the type and id identifying a resource (or resource identifier)
+func jsonAPIKey(resource map[string]any) ([2]string, bool) {
+	t, ok := resource["type"].(string)
+	if !ok {
+		return [2]string{}, false
+	}
+
+	id, ok := resource["id"].(string)
+	if !ok {
+		if id, ok = resource["lid"].(string); !ok {
+			return [2]string{}, false
+		}
+	}
+
+	return [2]string{t, id}, true
+}
+
+// jsonAPILinkage returns the resource linkage of a relationship object
+//
+// Relationship objects only contain data, links and meta members, with data holding
+// a resource identifier or a list of them.
+func jsonAPILinkage(m map[string]any) (any, bool) {
+	data, ok := m["data"]
+	if !ok {
+		return nil, false
+	}
+
+	for k := range m {
+		if k != "data" && k != "links" && k != "meta" {
+			return nil, false
+		}
+	}
+
+	switch d := data.(type) {
+	case map[string]any:
+		_, ok := jsonAPIKey(d)
+		return d, ok
+	case []any:
+		return d, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,57 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestFromJSONAPI(t *testing.T) {
+	document := map[string]any{}
+	err := json.Unmarshal([]byte(`{
+		"data": {
+			"type": "articles",
+			"id": "1",
+			"attributes": {"title": "Hello"},
+			"relationships": {
+				"author": {"data": {"type": "people", "id": "9"}, "links": {"self": "/articles/1/author"}},
+				"comments": {"data": [{"type": "comments", "id": "5"}, {"type": "comments", "id": "12"}]},
+				"editor": {"data": {"type": "people", "id": "404"}}
+			}
+		},
+		"included": [
+			{"type": "people", "id": "9", "attributes": {"name": "Dan"}},
+			{"type": "comments", "id": "5", "attributes": {"body": "First!"}, "relationships": {"author": {"data": {"type": "people", "id": "9"}}}},
+			{"type": "comments", "id": "12", "attributes": {"body": "Second"}}
+		]
+	}`), &document)
+	if err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	r := FromJSONAPI(document)
+
+	tests := map[string]string{
+		"data.id":                                   "1",
+		"data.attributes.title":                     "Hello",
+		"data.title":                                "Hello",
+		"data.relationships.author.name":            "Dan",
+		"data.author.name":                          "Dan",
+		"data.relationships.author.links.self":      "/articles/1/author",
+		"data.relationships.comments.1.body":        "Second",
+		"data.comments.0.author.name":               "Dan",
+		"data.relationships.author.attributes.name": "Dan",
+	}
+
+	for path, expected := range tests {
+		t.Run(path, func(t *testing.T) {
+			if result, err := r.StrErr(path); err != nil || result != expected {
+				t.Errorf("Expected: %s but got: %s (%v)", expected, result, err)
+			}
+		})
+	}
+
+	if _, err := r.StrErr("data.relationships.editor.name"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected error: %v, but got: %v", ErrKeyNotFound, err)
+	}
+}
@@ -0,0 +1,542 @@
+package mapreader
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Query returns every value matched by a JSONPath expression, ignoring any errors
+//
+// Use mapreader.QueryErr if you would like errors to be returned
+func Query[T any](source map[string]any, expr string) []T {
+	return withoutError(QueryErr[T](source, expr))
+}
+
+// QueryErr returns every value matched by a JSONPath expression, or returns an error
+//
+// A subset of JSONPath is supported:
+//   - the root "$", followed by any number of segments
+//   - child segments, written as ".name", ".*" or a bracketed list of selectors, e.g. "['name']"
+//   - descendant segments, written as "..name", "..*" or "..[selectors]"
+//   - name ('name' or "name"), wildcard (*), index (0 or -1) and slice (1:3) selectors, and unions of them, e.g. "[0,2]"
+//   - filter selectors, e.g. "[?(@.price < 10 && @.isbn)]", comparing relative paths (@.a.b or @['a'])
+//     with each other, or with number, string, true, false and null literals, using ==, !=, <, <=, > and >=.
+//     Conditions may be combined with && and ||, negated with !, and grouped with parentheses.
+//
+// e.g. QueryErr[string](source, "$.store.book[?(@.price < 10)].title") returns the titles of the cheaper books.
+// Parts of the source that don't match are skipped, as in JSONPath. Invalid expressions return ErrInvalidPath,
+// and values that can't be converted into T return an error annotated with their path.
+func QueryErr[T any](source map[string]any, expr string) ([]T, error) {
+	return query[T](source, expr, &defaultOptions)
+}
+
+// querySegment is a single segment of a JSONPath expression, applying its selectors to a node or its descendants
+type querySegment struct {
+	descendant bool
+	selectors  []querySelector
+}
+
+// querySelector selects children of a node, by name, index, range, wildcard or filter
+type querySelector struct {
+	name     *string
+	index    *int
+	rangeKey string
+	wildcard bool
+	filter   queryFilter
+}
+
+// queryFilter reports whether a node matches a filter expression
+type queryFilter func(node any) bool
+
+// queryOperand evaluates one side of a filter comparison against a node, returning false if it doesn't resolve
+type queryOperand func(node any) (any, bool)
+
+// queryParser parses a JSONPath expression into its segments
+type queryParser struct {
+	expr string
+	pos  int
+	opts *Options
+}
+
+// query evaluates a JSONPath expression against the source, converting every match into T
+func query[T any](source map[string]any, expr string, opts *Options) ([]T, error) {
+	p := &queryParser{expr: expr, opts: opts}
+	segments, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []Result{{Path: "", Value: source}}
+	for _, segment := range segments {
+		nodes = segment.apply(nodes, opts)
+	}
+
+	result := make([]T, len(nodes))
+	for i, node := range nodes {
+		value, err := convertValue(node.Value, opts, asType[T])
+		if err != nil {
+			return nil, lookupError(expr, fmt.Errorf("path '%s': %w", node.Path, err), opts)
+		}
+		result[i] = value
+	}
+
+	return result, nil
+}
+
+// apply returns the nodes selected by the segment from each of the given nodes
+func (s querySegment) apply(nodes []Result, opts *Options) []Result {
+	if s.descendant {
+		var all []Result
+		for _, node := range nodes {
+			all = appendDescendants(all, node, opts)
+		}
+		nodes = all
+	}
+
+	var selected []Result
+	for _, node := range nodes {
+		for _, selector := range s.selectors {
+			selected = selector.apply(selected, node, opts)
+		}
+	}
+
+	return selected
+}
+
+// apply appends the children of the node matched by the selector
+func (s querySelector) apply(selected []Result, node Result, opts *Options) []Result {
+	child := func(key string) {
+		if value, err := step(node.Value, key, opts); err == nil {
+			selected = append(selected, Result{Path: joinPath(node.Path, key), Value: value})
+		}
+	}
+
+	switch {
+	case s.name != nil:
+		if !isSlice(node.Value) {
+			child(*s.name)
+		}
+	case s.index != nil:
+		if isSlice(node.Value) {
+			child(strconv.Itoa(*s.index))
+		}
+	case s.rangeKey != "":
+		if n, err := length(node.Value, opts); err == nil && isSlice(node.Value) {
+			start, end, _ := sliceRange(s.rangeKey, n)
+			for i := start; i < end; i++ {
+				child(strconv.Itoa(i))
+			}
+		}
+	default:
+		for _, key := range childKeys(node.Value) {
+			value, err := step(node.Value, key, opts)
+			if err == nil && (s.wildcard || s.filter(value)) {
+				selected = append(selected, Result{Path: joinPath(node.Path, key), Value: value})
+			}
+		}
+	}
+
+	return selected
+}
+
+// appendDescendants appends the node and all of its descendants, depth first
+func appendDescendants(nodes []Result, node Result, opts *Options) []Result {
+	nodes = append(nodes, node)
+	for _, key := range childKeys(node.Value) {
+		if value, err := step(node.Value, key, opts); err == nil {
+			nodes = appendDescendants(nodes, Result{Path: joinPath(node.Path, key), Value: value}, opts)
+		}
+	}
+
+	return nodes
+}
+
+// parse parses the whole expression, which must start with the root identifier
+func (p *queryParser) parse() ([]querySegment, error) {
+	p.skipSpace()
+	if !p.consume("$") {
+		return nil, p.errorf("expected '$'")
+	}
+
+	var segments []querySegment
+	for p.skipSpace(); p.pos < len(p.expr); p.skipSpace() {
+		segment, err := p.parseSegment()
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, segment)
+	}
+
+	return segments, nil
+}
+
+// parseSegment parses a single child or descendant segment
+func (p *queryParser) parseSegment() (querySegment, error) {
+	var segment querySegment
+	switch {
+	case p.consume(".."):
+		segment.descendant = true
+		if p.peek() == '[' {
+			break
+		}
+
+		selector, err := p.parseMember()
+		segment.selectors = []querySelector{selector}
+		return segment, err
+	case p.consume("."):
+		selector, err := p.parseMember()
+		segment.selectors = []querySelector{selector}
+		return segment, err
+	case p.peek() != '[':
+		return segment, p.errorf("expected '.' or '['")
+	}
+
+	selectors, err := p.parseBracket()
+	segment.selectors = selectors
+	return segment, err
+}
+
+// parseMember parses the name or wildcard following a '.'
+func (p *queryParser) parseMember() (querySelector, error) {
+	if p.consume("*") {
+		return querySelector{wildcard: true}, nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.expr) && !strings.ContainsRune(".[]() <>=!&|,", rune(p.expr[p.pos])) {
+		p.pos++
+	}
+
+	if p.pos == start {
+		return querySelector{}, p.errorf("expected a member name")
+	}
+
+	name := p.expr[start:p.pos]
+	return querySelector{name: &name}, nil
+}
+
+// parseBracket parses a bracketed, comma separated list of selectors
+func (p *queryParser) parseBracket() ([]querySelector, error) {
+	p.consume("[")
+
+	var selectors []querySelector
+	for {
+		p.skipSpace()
+		selector, err := p.parseSelector()
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selector)
+
+		p.skipSpace()
+		switch {
+		case p.consume("]"):
+			return selectors, nil
+		case !p.consume(","):
+			return nil, p.errorf("expected ',' or ']'")
+		}
+	}
+}
+
+// parseSelector parses a single selector within brackets
+func (p *queryParser) parseSelector() (querySelector, error) {
+	switch c := p.peek(); {
+	case c == '*':
+		p.pos++
+		return querySelector{wildcard: true}, nil
+	case c == '\'' || c == '"':
+		name, err := p.parseString()
+		return querySelector{name: &name}, err
+	case c == '?':
+		p.pos++
+		filter, err := p.parseOr()
+		return querySelector{filter: filter}, err
+	}
+
+	start := p.pos
+	for p.pos < len(p.expr) && !strings.ContainsRune(",] ", rune(p.expr[p.pos])) {
+		p.pos++
+	}
+
+	raw := p.expr[start:p.pos]
+	if isRange(raw) {
+		return querySelector{rangeKey: raw}, nil
+	}
+
+	i, err := strconv.Atoi(raw)
+	if err != nil {
+		return querySelector{}, p.errorf("invalid selector '%s'", raw)
+	}
+
+	return querySelector{index: &i}, nil
+}
+
+// parseOr parses filter conditions joined by ||
+func (p *queryParser) parseOr() (queryFilter, error) {
+	left, err := p.parseAnd()
+	for err == nil && p.consumeOperator("||") {
+		var right queryFilter
+		if right, err = p.parseAnd(); err == nil {
+			l := left
+			left = func(node any) bool { return l(node) || right(node) }
+		}
+	}
+
+	return left, err
+}
+
+// parseAnd parses filter conditions joined by &&
+func (p *queryParser) parseAnd() (queryFilter, error) {
+	left, err := p.parseCondition()
+	for err == nil && p.consumeOperator("&&") {
+		var right queryFilter
+		if right, err = p.parseCondition(); err == nil {
+			l := left
+			left = func(node any) bool { return l(node) && right(node) }
+		}
+	}
+
+	return left, err
+}
+
+// parseCondition parses a negated or grouped condition, an existence test or a comparison
+func (p *queryParser) parseCondition() (queryFilter, error) {
+	p.skipSpace()
+	switch {
+	case p.consume("!"):
+		filter, err := p.parseCondition()
+		if err != nil {
+			return nil, err
+		}
+
+		return func(node any) bool { return !filter(node) }, nil
+	case p.consume("("):
+		filter, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.skipSpace(); !p.consume(")") {
+			return nil, p.errorf("expected ')'")
+		}
+
+		return filter, nil
+	}
+
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if !p.consume(op) {
+			continue
+		}
+
+		p.skipSpace()
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+
+		return func(node any) bool {
+			l, lok := left(node)
+			r, rok := right(node)
+			return lok && rok && compareQueryValues(l, op, r)
+		}, nil
+	}
+
+	return func(node any) bool {
+		_, ok := left(node)
+		return ok
+	}, nil
+}
+
+// parseOperand parses a relative path (starting with '@') or a literal
+func (p *queryParser) parseOperand() (queryOperand, error) {
+	switch c := p.peek(); {
+	case c == '@':
+		p.pos++
+		return p.parseRelativePath()
+	case c == '\'' || c == '"':
+		s, err := p.parseString()
+		return func(any) (any, bool) { return s, true }, err
+	}
+
+	start := p.pos
+	for p.pos < len(p.expr) && !strings.ContainsRune(" )]=!<>&|,", rune(p.expr[p.pos])) {
+		p.pos++
+	}
+
+	var literal any
+	switch raw := p.expr[start:p.pos]; raw {
+	case "true":
+		literal = true
+	case "false":
+		literal = false
+	case "null":
+		literal = nil
+	default:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, p.errorf("invalid literal '%s'", raw)
+		}
+		literal = f
+	}
+
+	return func(any) (any, bool) { return literal, true }, nil
+}
+
+// parseRelativePath parses the names and indexes following a '@', returning an operand that looks them up
+func (p *queryParser) parseRelativePath() (queryOperand, error) {
+	var keys []string
+	for {
+		switch {
+		case p.peek() == '.':
+			p.pos++
+			selector, err := p.parseMember()
+			if err != nil || selector.wildcard {
+				return nil, p.errorf("expected a member name")
+			}
+			keys = append(keys, *selector.name)
+		case p.peek() == '[':
+			p.pos++
+			p.skipSpace()
+			selector, err := p.parseSelector()
+			if err != nil {
+				return nil, err
+			}
+
+			switch {
+			case selector.name != nil:
+				keys = append(keys, *selector.name)
+			case selector.index != nil:
+				keys = append(keys, strconv.Itoa(*selector.index))
+			default:
+				return nil, p.errorf("expected a name or index")
+			}
+
+			if p.skipSpace(); !p.consume("]") {
+				return nil, p.errorf("expected ']'")
+			}
+		default:
+			opts := p.opts
+			return func(node any) (any, bool) {
+				for _, key := range keys {
+					next, err := step(node, key, opts)
+					if err != nil {
+						return nil, false
+					}
+					node = next
+				}
+
+				return node, true
+			}, nil
+		}
+	}
+}
+
+// parseString parses a single or double quoted string, with backslash escapes
+func (p *queryParser) parseString() (string, error) {
+	quote := p.expr[p.pos]
+	var b strings.Builder
+	for i := p.pos + 1; i < len(p.expr); i++ {
+		switch p.expr[i] {
+		case '\\':
+			if i++; i < len(p.expr) {
+				b.WriteByte(p.expr[i])
+			}
+		case quote:
+			p.pos = i + 1
+			return b.String(), nil
+		default:
+			b.WriteByte(p.expr[i])
+		}
+	}
+
+	return "", p.errorf("unterminated string")
+}
+
+// peek returns the next character of the expression, or 0 at its end
+func (p *queryParser) peek() byte {
+	if p.pos < len(p.expr) {
+		return p.expr[p.pos]
+	}
+
+	return 0
+}
+
+// consume advances past s if the expression continues with it
+func (p *queryParser) consume(s string) bool {
+	if strings.HasPrefix(p.expr[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+
+	return false
+}
+
+// consumeOperator skips any spaces, then advances past the operator if the expression continues with it
+func (p *queryParser) consumeOperator(op string) bool {
+	p.skipSpace()
+	return p.consume(op)
+}
+
+// skipSpace advances past any spaces
+func (p *queryParser) skipSpace() {
+	for p.pos < len(p.expr) && p.expr[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// errorf returns an ErrInvalidPath error describing a problem at the current position of the expression
+func (p *queryParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s at position %d of '%s'", ErrInvalidPath, fmt.Sprintf(format, args...), p.pos, p.expr)
+}
+
+// compareQueryValues compares two filter operands, numerically if both are numbers
+//
+// Strings are compared lexically, and other values can only be compared with == and !=.
+func compareQueryValues(l any, op string, r any) bool {
+	lf, lerr := asNumberType[float64](l)
+	rf, rerr := asNumberType[float64](r)
+	if lerr == nil && rerr == nil {
+		return compareOrdered(lf, op, rf)
+	}
+
+	ls, lok := l.(string)
+	rs, rok := r.(string)
+	if lok && rok {
+		return compareOrdered(ls, op, rs)
+	}
+
+	switch op {
+	case "==":
+		return reflect.DeepEqual(l, r)
+	case "!=":
+		return !reflect.DeepEqual(l, r)
+	default:
+		return false
+	}
+}
+
+// compareOrdered compares two ordered values with the given operator
+func compareOrdered[T float64 | string](l T, op string, r T) bool {
+	switch op {
+	case "==":
+		return l == r
+	case "!=":
+		return l != r
+	case "<":
+		return l < r
+	case "<=":
+		return l <= r
+	case ">":
+		return l > r
+	default:
+		return l >= r
+	}
+}
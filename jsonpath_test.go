@@ -0,0 +1,80 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestQueryErr(t *testing.T) {
+	source := map[string]any{}
+	err := json.Unmarshal([]byte(`{
+		"store": {
+			"book": [
+				{"category": "reference", "author": "Nigel Rees", "title": "Sayings of the Century", "price": 8.95},
+				{"category": "fiction", "author": "Evelyn Waugh", "title": "Sword of Honour", "price": 12.99},
+				{"category": "fiction", "author": "Herman Melville", "title": "Moby Dick", "isbn": "0-553-21311-3", "price": 8.99},
+				{"category": "fiction", "author": "J. R. R. Tolkien", "title": "The Lord of the Rings", "isbn": "0-395-19395-8", "price": 22.99}
+			],
+			"bicycle": {"color": "red", "price": 399}
+		},
+		"odd.key": {"a b": 1}
+	}`), &source)
+	if err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	tests := map[string]struct {
+		expected    []any
+		expectedErr error
+	}{
+		"$.store.book[*].author":                        {expected: []any{"Nigel Rees", "Evelyn Waugh", "Herman Melville", "J. R. R. Tolkien"}},
+		"$..author":                                     {expected: []any{"Nigel Rees", "Evelyn Waugh", "Herman Melville", "J. R. R. Tolkien"}},
+		"$.store..price":                                {expected: []any{399.0, 8.95, 12.99, 8.99, 22.99}},
+		"$..book[2].title":                              {expected: []any{"Moby Dick"}},
+		"$..book[-1].title":                             {expected: []any{"The Lord of the Rings"}},
+		"$..book[0,1].title":                            {expected: []any{"Sayings of the Century", "Sword of Honour"}},
+		"$..book[:2].title":                             {expected: []any{"Sayings of the Century", "Sword of Honour"}},
+		"$..book[?(@.isbn)].title":                      {expected: []any{"Moby Dick", "The Lord of the Rings"}},
+		"$.store.book[?(@.price<10)].title":             {expected: []any{"Sayings of the Century", "Moby Dick"}},
+		"$.store.book[?(@.price < 10 && @.isbn)].title": {expected: []any{"Moby Dick"}},
+		"$.store.book[?(@.price > 20 || @['category'] == 'reference')].title": {
+			expected: []any{"Sayings of the Century", "The Lord of the Rings"},
+		},
+		"$.store.book[?(!(@.category == \"fiction\"))].price":              {expected: []any{8.95}},
+		"$.store.book[?@.author != 'Nigel Rees' && @.price <= 8.99].title": {expected: []any{"Moby Dick"}},
+		"$.store['bicycle','missing'].color":                               {expected: []any{"red"}},
+		"$['odd.key']['a b']":                                              {expected: []any{1.0}},
+		"$.store.missing":                                                  {expected: []any{}},
+		"$.store.book.title":                                               {expected: []any{}},
+		"$":                                                                {expected: []any{source}},
+		"store.book":                                                       {expectedErr: ErrInvalidPath},
+		"$.store[":                                                         {expectedErr: ErrInvalidPath},
+		"$.store.book[?(@.price < )]":                                      {expectedErr: ErrInvalidPath},
+		"$.store.book[?(@.price < 10]":                                     {expectedErr: ErrInvalidPath},
+		"$.store['unterminated]":                                           {expectedErr: ErrInvalidPath},
+		"$.store.book[x]":                                                  {expectedErr: ErrInvalidPath},
+	}
+
+	for expr, tc := range tests {
+		t.Run(expr, func(t *testing.T) {
+			result, err := QueryErr[any](source, expr)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if tc.expectedErr == nil && !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+
+	if _, err := QueryErr[string](source, "$..price"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	if result := Query[float64](source, "$..book[?(@.price > 20)].price"); !reflect.DeepEqual(result, []float64{22.99}) {
+		t.Errorf("Expected: [22.99] but got: %v", result)
+	}
+}
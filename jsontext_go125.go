@@ -0,0 +1,15 @@
+//go:build goexperiment.jsonv2 && !go1.27
+
+package mapreader
+
+import "encoding/json/jsontext"
+
+// jsontext is available from go1.25 under GOEXPERIMENT=jsonv2, but is only part of the go1.27 API,
+// so these aliases are declared for each version, keeping jsonv2.go free of a go1.27 constraint.
+
+type (
+	jsonTextDecoder = jsontext.Decoder
+	jsonText        = jsontext.Value
+)
+
+var newJSONTextDecoder = jsontext.NewDecoder
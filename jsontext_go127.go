@@ -0,0 +1,14 @@
+//go:build goexperiment.jsonv2 && go1.27
+
+package mapreader
+
+import "encoding/json/jsontext"
+
+// Aliases of the jsontext API used by jsonv2.go, matching those in jsontext_go125.go
+
+type (
+	jsonTextDecoder = jsontext.Decoder
+	jsonText        = jsontext.Value
+)
+
+var newJSONTextDecoder = jsontext.NewDecoder
@@ -0,0 +1,58 @@
+//go:build !goexperiment.jsonv2
+
+package mapreader
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// decodeJSON decodes a single JSON value using encoding/json, preserving integers as int64
+func decodeJSON(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("invalid character after top-level value")
+	}
+
+	return jsonNumbers(value)
+}
+
+// jsonNumbers replaces the json.Number values of a decoded JSON value with int64 or float64 values
+func jsonNumbers(value any) (any, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return parseJSONNumber(v.String())
+	case map[string]any:
+		for k, e := range v {
+			n, err := jsonNumbers(e)
+			if err != nil {
+				return nil, err
+			}
+			v[k] = n
+		}
+	case []any:
+		for i, e := range v {
+			n, err := jsonNumbers(e)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = n
+		}
+	}
+
+	return value, nil
+}
+
+// jsonTextValue decodes jsontext.Value nodes, which require encoding/json/v2
+func jsonTextValue(any) (any, bool) {
+	return nil, false
+}
@@ -0,0 +1,100 @@
+//go:build goexperiment.jsonv2
+
+package mapreader
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+// decodeJSON decodes a single JSON value using encoding/json/jsontext, preserving integers as int64
+func decodeJSON(data []byte) (any, error) {
+	dec := newJSONTextDecoder(bytes.NewReader(data))
+
+	value, err := decodeJSONText(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := dec.ReadToken(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("invalid character after top-level value")
+	}
+
+	return value, nil
+}
+
+// decodeJSONText decodes the next JSON value from the decoder into maps, slices and scalar values
+func decodeJSONText(dec *jsonTextDecoder) (any, error) {
+	switch dec.PeekKind() {
+	case '{':
+		if _, err := dec.ReadToken(); err != nil {
+			return nil, err
+		}
+
+		m := map[string]any{}
+		for dec.PeekKind() != '}' {
+			name, err := dec.ReadToken()
+			if err != nil {
+				return nil, err
+			}
+			key := name.String()
+
+			v, err := decodeJSONText(dec)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = v
+		}
+
+		_, err := dec.ReadToken()
+		return m, err
+	case '[':
+		if _, err := dec.ReadToken(); err != nil {
+			return nil, err
+		}
+
+		s := []any{}
+		for dec.PeekKind() != ']' {
+			v, err := decodeJSONText(dec)
+			if err != nil {
+				return nil, err
+			}
+			s = append(s, v)
+		}
+
+		_, err := dec.ReadToken()
+		return s, err
+	}
+
+	tok, err := dec.ReadToken()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok.Kind() {
+	case '"':
+		return tok.String(), nil
+	case '0':
+		return parseJSONNumber(tok.String())
+	case 't', 'f':
+		return tok.Bool(), nil
+	default:
+		return nil, nil
+	}
+}
+
+// jsonTextValue decodes a jsontext.Value node, allowing lookups to traverse raw JSON held within a source
+func jsonTextValue(v any) (any, bool) {
+	raw, ok := v.(jsonText)
+	if !ok {
+		return nil, false
+	}
+
+	value, err := decodeJSON(raw)
+	if err != nil {
+		return nil, false
+	}
+
+	return value, true
+}
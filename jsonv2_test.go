@@ -0,0 +1,51 @@
+//go:build goexperiment.jsonv2 && go1.27
+
+package mapreader
+
+import (
+	"encoding/json/jsontext"
+	"encoding/json/v2"
+	"testing"
+)
+
+func TestJSONTextValues(t *testing.T) {
+	source := map[string]any{
+		"user": jsontext.Value(`{"name": "Dan", "id": 9007199254740993, "roles": ["admin"]}`),
+		"note": jsontext.Value(`"hello"`),
+	}
+
+	if result, err := StrErr(source, "user.name"); err != nil || result != "Dan" {
+		t.Errorf("Expected: Dan but got: %s (%v)", result, err)
+	}
+
+	if result, err := NumberErr[int64](source, "user.id"); err != nil || result != 9007199254740993 {
+		t.Errorf("Expected: 9007199254740993 but got: %d (%v)", result, err)
+	}
+
+	if result, err := StrErr(source, "user.roles.0"); err != nil || result != "admin" {
+		t.Errorf("Expected: admin but got: %s (%v)", result, err)
+	}
+
+	if result, err := StrErr(source, "note"); err != nil || result != "hello" {
+		t.Errorf("Expected: hello but got: %s (%v)", result, err)
+	}
+
+	type event struct {
+		ID      int64          `json:"id"`
+		Payload jsontext.Value `json:"payload"`
+	}
+
+	decoded := map[string]event{}
+	if err := json.Unmarshal([]byte(`{"latest": {"id": 9007199254740993, "payload": {"kind": "click"}}}`), &decoded); err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	source = map[string]any{"events": decoded}
+	if result, err := NumberErr[int64](source, "events.latest.id"); err != nil || result != 9007199254740993 {
+		t.Errorf("Expected: 9007199254740993 but got: %d (%v)", result, err)
+	}
+
+	if result, err := StrErr(source, "events.latest.payload.kind"); err != nil || result != "click" {
+		t.Errorf("Expected: click but got: %s (%v)", result, err)
+	}
+}
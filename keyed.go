@@ -0,0 +1,123 @@
+package mapreader
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ByKey returns the first object of the slice at the given array path whose field matches the given value
+//
+// This is equivalent to the lookup path segment "[field=value]", e.g. "users.[id=42]".
+// Values are matched by their textual form, so 42 matches both 42 and "42".
+// Use mapreader.IndexBy when looking up many objects from the same slice.
+func ByKey(source map[string]any, arrayPath, field string, value any) (map[string]any, error) {
+	return byKey(source, arrayPath, field, value, &defaultOptions)
+}
+
+// byKey returns the first object of the slice at the given array path whose field matches the given value
+func byKey(source map[string]any, arrayPath, field string, value any, opts *Options) (map[string]any, error) {
+	in, err := get(source, arrayPath, opts, asType[[]any])
+	if err != nil {
+		return nil, err
+	}
+
+	element, err := selectByKey(len(in), func(i int) any { return in[i] }, field, keyString(value), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return asType[map[string]any](element)
+}
+
+// IndexBy indexes the objects of the slice at the given array path by the textual form of the given field
+//
+// The index can itself be used as a source, so repeated lookups don't rescan the slice,
+// e.g. idx, err := IndexBy(source, "users", "id") followed by Str(idx, "42.email").
+// Objects missing the field are left out, and where several objects share a value, the first is indexed.
+func IndexBy(source map[string]any, arrayPath, field string) (map[string]any, error) {
+	return indexBy(source, arrayPath, field, &defaultOptions)
+}
+
+// indexBy indexes the objects of the slice at the given array path by the textual form of the given field
+func indexBy(source map[string]any, arrayPath, field string, opts *Options) (map[string]any, error) {
+	in, err := get(source, arrayPath, opts, asType[[]any])
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]any, len(in))
+	for _, element := range in {
+		value, err := keyField(element, field, opts)
+		if err != nil {
+			continue
+		}
+
+		if k := keyString(value); index[k] == nil {
+			index[k] = element
+		}
+	}
+
+	return index, nil
+}
+
+// keySelector parses a path segment of the form "[field=value]"
+func keySelector(key string) (field, value string, ok bool) {
+	if len(key) < 2 || key[0] != '[' || key[len(key)-1] != ']' {
+		return "", "", false
+	}
+
+	return strings.Cut(key[1:len(key)-1], "=")
+}
+
+// selectByKey returns the first element of a slice whose field has the textual form of the given value
+func selectByKey(length int, element func(int) any, field, value string, opts *Options) (any, error) {
+	for i := 0; i < length; i++ {
+		e := element(i)
+		if v, err := keyField(e, field, opts); err == nil && matchesKey(v, value) {
+			return e, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no element with %s=%s", ErrKeyNotFound, field, value)
+}
+
+// keyField returns the value of the field of an element, which may itself be a lookup path within a map element
+func keyField(element any, field string, opts *Options) (any, error) {
+	if m, ok := element.(map[string]any); ok {
+		return lookup(m, field, opts)
+	}
+
+	return step(element, field, opts)
+}
+
+// keyString returns the textual form of a value used to match keyed lookups
+func keyString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// matchesKey reports whether a value matches the textual form of a keyed lookup
+//
+// Numeric values also match equal numbers in other forms, e.g. 42 matches "42.0".
+func matchesKey(value any, want string) bool {
+	if keyString(value) == want {
+		return true
+	}
+
+	f, err := asNumberType[float64](value)
+	if err != nil {
+		return false
+	}
+
+	w, err := strconv.ParseFloat(want, 64)
+	return err == nil && f == w
+}
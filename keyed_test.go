@@ -0,0 +1,107 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestKeyedLookup(t *testing.T) {
+	source := map[string]any{}
+	err := json.Unmarshal([]byte(`{
+		"users": [
+			{"id": 7, "email": "jo@example.com", "profile": {"handle": "jo"}},
+			{"id": 42, "email": "dan@example.com", "profile": {"handle": "dan"}},
+			{"id": "x1", "email": "x@example.com"}
+		],
+		"releases": [{"version": "1.5", "name": "stable"}]
+	}`), &source)
+	if err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+	source["typed"] = []map[string]any{{"id": int64(3), "name": "three"}}
+
+	tests := map[string]struct {
+		expected    string
+		expectedErr error
+	}{
+		"users.[id=42].email":             {expected: "dan@example.com"},
+		"users.[id=42.0].email":           {expected: "dan@example.com"},
+		"users.[id=x1].email":             {expected: "x@example.com"},
+		"users.[profile.handle=jo].email": {expected: "jo@example.com"},
+		"releases.[version=1.5].name":     {expected: "stable"},
+		"typed.[id=3].name":               {expected: "three"},
+		"users.[id=99].email":             {expectedErr: ErrKeyNotFound},
+		"users.[email].id":                {expectedErr: ErrNonIntegerSliceAccess},
+	}
+
+	for path, tc := range tests {
+		t.Run(path, func(t *testing.T) {
+			result, err := StrErr(source, path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("Expected: %s but got: %s", tc.expected, result)
+			}
+		})
+	}
+
+	user, err := ByKey(source, "users", "id", 42)
+	if err != nil || user["email"] != "dan@example.com" {
+		t.Errorf("Expected: dan@example.com but got: %v (%v)", user, err)
+	}
+
+	if _, err := ByKey(source, "users", "id", 99); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected error: %v, but got: %v", ErrKeyNotFound, err)
+	}
+
+	index, err := IndexBy(source, "users", "id")
+	if err != nil || len(index) != 3 {
+		t.Fatalf("Expected: 3 indexed users but got: %v (%v)", index, err)
+	}
+
+	if result, err := StrErr(index, "42.email"); err != nil || result != "dan@example.com" {
+		t.Errorf("Expected: dan@example.com but got: %s (%v)", result, err)
+	}
+
+	if result, err := StrErr(index, "x1.profile.handle"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected error: %v, but got: %s (%v)", ErrKeyNotFound, result, err)
+	}
+
+	if _, err := StrErr(map[string]any{"[id=1]": "literal"}, `\[id=1\]`); err != nil {
+		t.Errorf("Map keys that look like selectors should still be found, got: %v", err)
+	}
+}
+
+func TestReaderKeyedLookup(t *testing.T) {
+	source := map[string]any{
+		"org": []any{map[string]any{
+			"users": []any{
+				map[string]any{"ID": 7, "email": "jo@example.com"},
+				map[string]any{"ID": 42, "email": "dan@example.com"},
+			},
+		}},
+	}
+
+	r := New(source, WithSingletonListUnwrap(), WithCaseInsensitiveKeys())
+
+	user, err := r.ByKey("org.users", "id", 42)
+	if err != nil || user["email"] != "dan@example.com" {
+		t.Errorf("Expected: dan@example.com but got: %v (%v)", user, err)
+	}
+
+	index, err := r.IndexBy("org.users", "id")
+	if err != nil || len(index) != 2 {
+		t.Fatalf("Expected: 2 indexed users but got: %v (%v)", index, err)
+	}
+
+	if result, err := StrErr(index, "7.email"); err != nil || result != "jo@example.com" {
+		t.Errorf("Expected: jo@example.com but got: %s (%v)", result, err)
+	}
+
+	if _, err := ByKey(source, "org.users", "id", 42); !errors.Is(err, ErrNonIntegerSliceAccess) {
+		t.Errorf("Package level lookups shouldn't use Reader options, but got: %v", err)
+	}
+}
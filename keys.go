@@ -0,0 +1,83 @@
+package mapreader
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Keys returns the sorted keys of the map found at the given lookup path, ignoring any errors
+//
+// If any error is encountered, it returns nil.
+// Use mapreader.KeysErr if you would like errors to be returned
+func Keys(source map[string]any, path string) []string {
+	return withoutError(KeysErr(source, path))
+}
+
+// KeysErr returns the sorted keys of the map found at the given lookup path, or returns an error
+//
+// Keys are sorted so that iterating over them is deterministic, and typed maps (e.g. map[int]string)
+// have their keys formatted as strings. Values other than maps return ErrUnexpectedType.
+// Use mapreader.Keys if you would like to ignore errors
+func KeysErr(source map[string]any, path string) ([]string, error) {
+	return get(source, path, &defaultOptions, asKeys)
+}
+
+// Values returns the values of the map found at the given lookup path in key order, converted to the given type, ignoring any errors
+//
+// If any error is encountered, it returns nil.
+// Use mapreader.ValuesErr if you would like errors to be returned
+func Values[T any](source map[string]any, path string) []T {
+	return withoutError(ValuesErr[T](source, path))
+}
+
+// ValuesErr returns the values of the map found at the given lookup path in key order, converted to the given type,
+// or returns an error
+//
+// This suits maps keyed by ID, where only the values matter. Values are returned in the order of their sorted keys,
+// and are converted as by mapreader.GetErr, with the first failure annotated with the key of the value that failed.
+// Use mapreader.Values if you would like to ignore errors
+func ValuesErr[T any](source map[string]any, path string) ([]T, error) {
+	return get(source, path, &defaultOptions, asValues[T])
+}
+
+// ReadValues is the Reader equivalent of mapreader.Values
+func ReadValues[T any](r *Reader, path string) []T {
+	return withoutError(ReadValuesErr[T](r, path))
+}
+
+// ReadValuesErr is the Reader equivalent of mapreader.ValuesErr
+func ReadValuesErr[T any](r *Reader, path string) ([]T, error) {
+	return get(r.source, path, &r.opts, asValues[T])
+}
+
+// asKeys returns the sorted keys of a map
+func asKeys(value any) ([]string, error) {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Map {
+		return nil, fmt.Errorf("%w: expected a map but got '%T'", ErrUnexpectedType, value)
+	}
+
+	return childKeys(v.Interface()), nil
+}
+
+// asValues converts the values of a map, in the order of their sorted keys, into a slice of the given type
+func asValues[T any](value any) ([]T, error) {
+	in, err := asType[map[string]any](value)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := childKeys(in)
+	result := make([]T, len(keys))
+	for i, k := range keys {
+		if result[i], err = asType[T](in[k]); err != nil {
+			return nil, fmt.Errorf("key '%s': %w", k, err)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,85 @@
+package mapreader
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestKeysErr(t *testing.T) {
+	source := map[string]any{
+		"orders": map[string]any{"b2": map[string]any{}, "a1": map[string]any{}, "c3": nil},
+		"typed":  map[int]string{2: "b", 1: "a"},
+		"empty":  map[string]any{},
+		"list":   []any{1, 2},
+		"name":   "Dan",
+	}
+
+	tests := map[string]struct {
+		path        string
+		expected    []string
+		expectedErr error
+	}{
+		"Sorted keys": {path: "orders", expected: []string{"a1", "b2", "c3"}},
+		"Typed map":   {path: "typed", expected: []string{"1", "2"}},
+		"Empty map":   {path: "empty", expected: []string{}},
+		"Slice":       {path: "list", expectedErr: ErrUnexpectedType},
+		"Scalar":      {path: "name", expectedErr: ErrUnexpectedType},
+		"Missing key": {path: "missing", expectedErr: ErrKeyNotFound},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := KeysErr(source, tc.path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+
+	if result := New(source).Keys("orders"); len(result) != 3 {
+		t.Errorf("Expected 3 keys but got: %v", result)
+	}
+}
+
+func TestValuesErr(t *testing.T) {
+	source := map[string]any{
+		"users": map[string]any{
+			"u2": map[string]any{"name": "Jo"},
+			"u1": map[string]any{"name": "Dan"},
+		},
+		"scores": map[string]int{"b": 2, "a": 1},
+		"mixed":  map[string]any{"a": "x", "b": 1},
+		"list":   []any{1},
+	}
+
+	users, err := ValuesErr[map[string]any](source, "users")
+	if err != nil || len(users) != 2 || users[0]["name"] != "Dan" || users[1]["name"] != "Jo" {
+		t.Errorf("Expected users in key order but got: %v (%v)", users, err)
+	}
+
+	if result, err := ValuesErr[int](source, "scores"); err != nil || !reflect.DeepEqual(result, []int{1, 2}) {
+		t.Errorf("Expected: [1 2] but got: %v (%v)", result, err)
+	}
+
+	if result := ReadValues[any](New(source), "mixed"); !reflect.DeepEqual(result, []any{"x", 1}) {
+		t.Errorf("Expected: [x 1] but got: %v", result)
+	}
+
+	if _, err := ValuesErr[string](source, "mixed"); !errors.Is(err, ErrUnexpectedType) || !strings.Contains(err.Error(), "key 'b'") {
+		t.Errorf("Expected error: %v for key 'b', but got: %v", ErrUnexpectedType, err)
+	}
+
+	if _, err := ValuesErr[any](source, "list"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	if result := Values[string](source, "missing"); result != nil {
+		t.Errorf("Expected: nil but got: %v", result)
+	}
+}
@@ -0,0 +1,113 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+// ValueKind describes the shape of a value within a document, as returned by mapreader.KindErr
+type ValueKind int
+
+// The kinds of value reported by mapreader.KindErr
+const (
+	KindMissing ValueKind = iota
+	KindNull
+	KindBool
+	KindNumber
+	KindString
+	KindSlice
+	KindMap
+	KindOther
+)
+
+// String returns the lower case name of the kind, e.g. "number"
+func (k ValueKind) String() string {
+	switch k {
+	case KindMissing:
+		return "missing"
+	case KindNull:
+		return "null"
+	case KindBool:
+		return "bool"
+	case KindNumber:
+		return "number"
+	case KindString:
+		return "string"
+	case KindSlice:
+		return "slice"
+	case KindMap:
+		return "map"
+	default:
+		return "other"
+	}
+}
+
+// Kind returns the kind of the value found at the given lookup path, ignoring any errors
+//
+// If any error is encountered, it returns KindMissing.
+// Use mapreader.KindErr if you would like errors to be returned
+func Kind(source map[string]any, path string) ValueKind {
+	return withoutError(KindErr(source, path))
+}
+
+// KindErr returns the kind of the value found at the given lookup path, or returns an error
+//
+// This allows code to branch on the shape of polymorphic fields without attempting several conversions.
+// Missing keys and indexes return KindMissing rather than an error, while invalid paths still return an error.
+// Go values are classified by their underlying kind, so typed maps and slices are KindMap and KindSlice,
+// json.Number is KindNumber, nil pointers, maps and slices are KindNull, and values such as structs are KindOther.
+// Use mapreader.Kind if you would like to ignore errors
+func KindErr(source map[string]any, path string) (ValueKind, error) {
+	return kindOf(source, path, &defaultOptions)
+}
+
+// kindOf returns the kind of the value found at the given path
+func kindOf(source map[string]any, path string, opts *Options) (ValueKind, error) {
+	value, err := lookup(source, path, opts)
+	switch {
+	case errors.Is(err, ErrKeyNotFound) || errors.Is(err, ErrIndexOutOfBounds):
+		return KindMissing, nil
+	case err != nil:
+		return KindMissing, lookupError(path, err, opts)
+	}
+
+	return valueKind(value), nil
+}
+
+// valueKind classifies a value by its underlying kind
+func valueKind(value any) ValueKind {
+	if _, ok := value.(json.Number); ok {
+		return KindNumber
+	}
+
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Invalid, reflect.Pointer:
+		return KindNull
+	case reflect.Bool:
+		return KindBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return KindNumber
+	case reflect.String:
+		return KindString
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return KindNull
+		}
+		return KindSlice
+	case reflect.Map:
+		if v.IsNil() {
+			return KindNull
+		}
+		return KindMap
+	default:
+		return KindOther
+	}
+}
@@ -0,0 +1,69 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestKindErr(t *testing.T) {
+	var nilMap map[string]any
+	var nilPtr *int
+
+	source := map[string]any{
+		"map":     map[string]any{"a": 1},
+		"typed":   map[string]int{"a": 1},
+		"slice":   []any{1},
+		"strings": []string{"a"},
+		"string":  "text",
+		"number":  float64(1),
+		"int":     int64(1),
+		"json":    json.Number("1"),
+		"bool":    true,
+		"null":    nil,
+		"nilMap":  nilMap,
+		"nilPtr":  nilPtr,
+		"time":    time.Now(),
+	}
+
+	tests := map[string]struct {
+		path        string
+		expected    ValueKind
+		expectedErr error
+	}{
+		"Map":           {path: "map", expected: KindMap},
+		"Typed map":     {path: "typed", expected: KindMap},
+		"Slice":         {path: "slice", expected: KindSlice},
+		"Typed slice":   {path: "strings", expected: KindSlice},
+		"String":        {path: "string", expected: KindString},
+		"Float":         {path: "number", expected: KindNumber},
+		"Int":           {path: "int", expected: KindNumber},
+		"JSON number":   {path: "json", expected: KindNumber},
+		"Bool":          {path: "bool", expected: KindBool},
+		"Null":          {path: "null", expected: KindNull},
+		"Nil map":       {path: "nilMap", expected: KindNull},
+		"Nil pointer":   {path: "nilPtr", expected: KindNull},
+		"Struct":        {path: "time", expected: KindOther},
+		"Missing key":   {path: "map.b", expected: KindMissing},
+		"Missing index": {path: "slice.5", expected: KindMissing},
+		"Invalid path":  {path: "map|unknown", expected: KindMissing, expectedErr: ErrInvalidPath},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := KindErr(source, tc.path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+
+	if result := New(source).Kind("string"); result.String() != "string" {
+		t.Errorf("Expected: string but got: %v", result)
+	}
+}
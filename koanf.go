@@ -0,0 +1,62 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// KoanfProvider exposes a Reader as a knadh/koanf Provider
+//
+// e.g. k.Load(r.Koanf(), nil) will load the whole source document into a koanf instance.
+type KoanfProvider struct {
+	r *Reader
+}
+
+// Koanf returns a KoanfProvider for the Reader
+func (r *Reader) Koanf() *KoanfProvider {
+	return &KoanfProvider{r: r}
+}
+
+// Read returns the underlying source document
+func (p *KoanfProvider) Read() (map[string]any, error) {
+	return p.r.source, nil
+}
+
+// ReadBytes returns the source document encoded as JSON, for use with a koanf JSON parser
+func (p *KoanfProvider) ReadBytes() ([]byte, error) {
+	return json.Marshal(p.r.source)
+}
+
+// Unflatten converts a flat map of delimited keys (such as the output of koanf's All) into nested maps
+//
+// e.g. {"a.b": 1} with the delimiter "." becomes {"a": {"b": 1}}
+// Where a key is both a value and a prefix of other keys, the nested keys take precedence.
+func Unflatten(flat map[string]any, delim string) map[string]any {
+	keys := make([]string, 0, len(flat))
+	for k := range flat {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	result := make(map[string]any)
+	for _, k := range keys {
+		parts := strings.Split(k, delim)
+		current := result
+		for _, part := range parts[:len(parts)-1] {
+			next, ok := current[part].(map[string]any)
+			if !ok {
+				next = make(map[string]any)
+				current[part] = next
+			}
+			current = next
+		}
+
+		last := parts[len(parts)-1]
+		if _, ok := current[last].(map[string]any); !ok {
+			current[last] = flat[k]
+		}
+	}
+
+	return result
+}
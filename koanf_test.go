@@ -0,0 +1,56 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestKoanfProvider(t *testing.T) {
+	source := map[string]any{"a": map[string]any{"b": "value"}}
+	p := New(source).Koanf()
+
+	result, err := p.Read()
+	if err != nil || !reflect.DeepEqual(result, source) {
+		t.Errorf("Expected: %v but got: %v (%v)", source, result, err)
+	}
+
+	data, err := p.ReadBytes()
+	if err != nil {
+		t.Fatalf("ReadBytes should not return an error, got: %v", err)
+	}
+
+	decoded := map[string]any{}
+	if err := json.Unmarshal(data, &decoded); err != nil || !reflect.DeepEqual(decoded, source) {
+		t.Errorf("Expected: %v but got: %v (%v)", source, decoded, err)
+	}
+}
+
+func TestUnflatten(t *testing.T) {
+	flat := map[string]any{
+		"server.host":  "localhost",
+		"server.port":  8080,
+		"debug":        true,
+		"db":           "overridden",
+		"db.name":      "app",
+		"db.pool.size": 4,
+	}
+
+	expected := map[string]any{
+		"server": map[string]any{"host": "localhost", "port": 8080},
+		"debug":  true,
+		"db": map[string]any{
+			"name": "app",
+			"pool": map[string]any{"size": 4},
+		},
+	}
+
+	result := Unflatten(flat, ".")
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected: %v but got: %v", expected, result)
+	}
+
+	if port := Int(result, "server.port"); port != 8080 {
+		t.Errorf("Expected: 8080 but got: %d", port)
+	}
+}
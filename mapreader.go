@@ -2,10 +2,17 @@
 package mapreader
 
 import (
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 type number interface {
@@ -17,10 +24,14 @@ type number interface {
 var (
 	ErrEndOfNestedStructures = errors.New("reached end of nested structures before lookup complete")
 	ErrIndexOutOfBounds      = errors.New("given index out of bounds")
+	ErrInvalidDestination    = errors.New("invalid destination")
+	ErrInvalidPath           = errors.New("invalid lookup path")
 	ErrKeyNotFound           = errors.New("key not found")
+	ErrNilValue              = errors.New("value is null")
 	ErrNonIntegerSliceAccess = errors.New("integer lookup required but string given")
 	ErrUnableToConvert       = errors.New("unable to convert to required type")
 	ErrUnexpectedType        = errors.New("result type is unexpected")
+	ErrUnknownFormat         = errors.New("unknown document format")
 )
 
 // GetErr is a function for generically returning any final value type, ignoring any errors
@@ -49,54 +60,146 @@ func GetDefault[T any](source map[string]any, path string, d T) T {
 	return result
 }
 
+// GetDefaultFunc returns the value found at the given lookup path, or the result of calling the default function
+//
+// The default function is only called for values that would otherwise error/aren't set,
+// so expensive defaults aren't computed on every successful read.
+func GetDefaultFunc[T any](source map[string]any, path string, d func() T) T {
+	result, err := GetErr[T](source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// GetDefaultOK returns the value found at the given lookup path, or the default value, reporting whether the default was used
+//
+// The default is only returned for values that would otherwise error/aren't set.
+// This allows callers to log or meter when a default has been applied.
+func GetDefaultOK[T any](source map[string]any, path string, d T) (T, bool) {
+	result, err := GetErr[T](source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
 // GetErr is a function for generically returning any final value type
 //
 // You may prefer using the specific typed functions such as StrErr, IntErr, etc
 // providing one is available for your required type.
 // Use mapreader.Get if you would like to ignore errors
+//
+// If the value is a string and T (or *T) implements encoding.TextUnmarshaler, the text will be unmarshalled into T.
+// e.g. GetErr[time.Time](source, path) will parse an RFC 3339 string value
+// Failing that, if T (or *T) implements json.Unmarshaler, the value will be marshalled to JSON and unmarshalled into T.
 func GetErr[T any](source map[string]any, path string) (T, error) {
-	var nilResult T
-	keys := strings.Split(path, ".")
-	depth := len(keys) - 1
+	return get(source, path, &defaultOptions, asType[T])
+}
 
-	var current any = source
+// GetInto assigns the value found at the given lookup path into dst, or returns an error
+//
+// On error, dst is left untouched. This allows struct fields to be assigned directly,
+// e.g. err := GetInto(source, "user.name", &user.Name)
+// Conversion follows the same rules as mapreader.GetErr
+func GetInto[T any](source map[string]any, path string, dst *T) error {
+	return getInto(source, path, &defaultOptions, dst)
+}
 
-	for i, k := range keys {
-		switch c := current.(type) {
-		case map[string]any:
-			v, ok := c[k]
-			if !ok {
-				return nilResult, fmt.Errorf("%w: %s", ErrKeyNotFound, k)
-			}
-			current = v
-		case []any:
-			i, err := strconv.Atoi(k)
-			if err != nil {
-				return nilResult, fmt.Errorf("%w: lookup was '%s'", ErrNonIntegerSliceAccess, k)
-			}
+// GetOK returns the value found at the given lookup path, and whether it was found and converted successfully
+//
+// This mirrors the comma-ok idiom of map lookups, e.g. if name, ok := GetOK[string](source, "user.name"); ok {...}
+// Unlike mapreader.GetDefaultOK, ok is true when the value is usable. On failure, the zero value of T is returned.
+func GetOK[T any](source map[string]any, path string) (T, bool) {
+	result, err := GetErr[T](source, path)
+	if err != nil {
+		return *new(T), false
+	}
 
-			if i < 0 || i > len(source)-1 {
-				return nilResult, fmt.Errorf("%w: index '%d' but length '%d'", ErrIndexOutOfBounds, i, len(source))
-			}
+	return result, true
+}
 
-			current = c[i]
-		default:
-			if i != depth {
-				return nilResult, fmt.Errorf("%w: last key was '%s'", ErrEndOfNestedStructures, k)
-			}
-		}
+// Has reports whether the given lookup path resolves to a value, including an explicit null
+//
+// Use mapreader.HasErr to distinguish a missing value from a path that can't be traversed
+func Has(source map[string]any, path string) bool {
+	ok, _ := HasErr(source, path)
+	return ok
+}
 
-		if i == depth {
-			result, ok := current.(T)
-			if !ok {
-				return nilResult, fmt.Errorf("%w: '%T'", ErrUnexpectedType, current)
-			}
+// HasErr reports whether the given lookup path resolves to a value, including an explicit null
+//
+// A missing key or out of bounds index returns false with a nil error. Other failures, such as
+// descending beyond a leaf value or indexing a slice with a non-integer key, return false with the error.
+func HasErr(source map[string]any, path string) (bool, error) {
+	return has(source, path, &defaultOptions)
+}
 
-			return result, nil
-		}
-	}
+// IsNull reports whether the value found at the given lookup path is an explicit null
+//
+// Missing values aren't null, so together with mapreader.Has this distinguishes missing, null and set values.
+// Getters also return an error wrapping ErrNilValue (as well as ErrUnexpectedType) when a null can't be converted.
+func IsNull(source map[string]any, path string) bool {
+	return isNull(source, path, &defaultOptions)
+}
+
+// Len returns the length of the value found at the given lookup path, ignoring any errors
+//
+// If any error is encountered, it returns 0.
+// Use mapreader.LenErr if you would like errors to be returned
+func Len(source map[string]any, path string) int {
+	return withoutError(LenErr(source, path))
+}
+
+// LenErr returns the length of the value found at the given lookup path, or returns an error
+//
+// This is the number of elements of a slice, the number of keys of a map, or the number of characters (runes)
+// of a string. Other values, including null, return ErrUnexpectedType.
+// Use mapreader.Len if you would like to ignore errors
+func LenErr(source map[string]any, path string) (int, error) {
+	return get(source, path, &defaultOptions, asLen)
+}
 
-	return nilResult, nil
+// GetNullable is a function for generically returning any final value type, distinguishing absent and null values
+//
+// A missing key or out of bounds index returns present as false with a nil error.
+// An explicit null returns present and isNull as true, with the zero value of T.
+// If the value is present but can't be converted, present is true and the conversion error is returned.
+func GetNullable[T any](source map[string]any, path string) (value T, present bool, isNull bool, err error) {
+	return getNullable[T](source, path, &defaultOptions)
+}
+
+// GetMany returns the values found at each of the given lookup paths in order, or returns the first error
+//
+// Errors are annotated with the path that failed.
+// Use mapreader.GetManyPartial if you would like the values of the paths that succeeded
+func GetMany[T any](source map[string]any, paths ...string) ([]T, error) {
+	return getMany[T](source, paths, &defaultOptions)
+}
+
+// GetManyPartial returns the values found at each of the given lookup paths in order, along with any errors
+//
+// Paths that fail leave the zero value of T in their position, and all failures are joined into the returned error.
+func GetManyPartial[T any](source map[string]any, paths ...string) ([]T, error) {
+	return getManyPartial[T](source, paths, &defaultOptions)
+}
+
+// First returns the value found at the first of the given lookup paths that resolves to a valid T, ignoring any errors
+//
+// Use mapreader.FirstErr if you would like errors to be returned
+func First[T any](source map[string]any, paths ...string) T {
+	return withoutError(FirstErr[T](source, paths...))
+}
+
+// FirstErr returns the value found at the first of the given lookup paths that resolves to a valid T, or returns an error
+//
+// Paths are tried in order, which suits fields that have moved between API versions,
+// e.g. FirstErr[string](source, "user.email", "user.contact.email")
+// If no path succeeds, the failure of each path is joined into the returned error.
+func FirstErr[T any](source map[string]any, paths ...string) (T, error) {
+	return getFirst[T](source, paths, &defaultOptions)
 }
 
 // Bool returns the bool value found at the given lookup path, ignoring any errors
@@ -112,6 +215,26 @@ func BoolDefault(source map[string]any, path string, d bool) bool {
 	return GetDefault(source, path, d)
 }
 
+// BoolDefaultFunc returns the bool value found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func BoolDefaultFunc(source map[string]any, path string, d func() bool) bool {
+	result, err := BoolErr(source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// BoolDefaultOK returns the bool value found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func BoolDefaultOK(source map[string]any, path string, d bool) (bool, bool) {
+	result, err := BoolErr(source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
 // BoolErr returns the bool value found at the given lookup path, or returns an error
 //
 // Use mapreader.Bool if you would like to ignore errors
@@ -119,6 +242,56 @@ func BoolErr(source map[string]any, path string) (bool, error) {
 	return GetErr[bool](source, path)
 }
 
+// BoolLenient returns the boolean value found at the given lookup path, ignoring any errors
+//
+// If any error is encountered, it returns false.
+// Use mapreader.BoolLenientErr if you would like errors to be returned
+func BoolLenient(source map[string]any, path string) bool {
+	return withoutError(BoolLenientErr(source, path))
+}
+
+// BoolLenientDefault returns the boolean value found at the given lookup path, or the default value
+//
+// The default is only returned for values that would otherwise error/aren't set.
+func BoolLenientDefault(source map[string]any, path string, d bool) bool {
+	result, err := BoolLenientErr(source, path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// BoolLenientDefaultFunc returns the boolean value found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func BoolLenientDefaultFunc(source map[string]any, path string, d func() bool) bool {
+	result, err := BoolLenientErr(source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// BoolLenientDefaultOK returns the boolean value found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func BoolLenientDefaultOK(source map[string]any, path string, d bool) (bool, bool) {
+	result, err := BoolLenientErr(source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// BoolLenientErr returns the boolean value found at the given lookup path, or returns an error
+//
+// Use mapreader.BoolLenient if you would like to ignore errors
+// In addition to booleans, it accepts the strings "true", "false", "yes", "no", "on", "off", "1" and "0"
+// regardless of case or surrounding whitespace, and the numbers 1 and 0.
+// If you would prefer to raise errors on these, use mapreader.BoolErr instead
+func BoolLenientErr(source map[string]any, path string) (bool, error) {
+	return get(source, path, &defaultOptions, asBoolLenient)
+}
+
 // Bytes returns the []byte value found at the given lookup path, ignoring any errors
 //
 // If any error is encountered, it returns the nil value.
@@ -141,24 +314,132 @@ func BytesDefault(source map[string]any, path string, d []byte) []byte {
 	return result
 }
 
+// BytesDefaultFunc returns the []byte value found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func BytesDefaultFunc(source map[string]any, path string, d func() []byte) []byte {
+	result, err := BytesErr(source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// BytesDefaultOK returns the []byte value found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func BytesDefaultOK(source map[string]any, path string, d []byte) ([]byte, bool) {
+	result, err := BytesErr(source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
 // BytesErr returns the []byte value found at the given lookup path, or returns an error
 //
 // Use mapreader.Byte if you would like to ignore errors
-// If you would prefer to raise errors on strings, use mapreader.GetErr[[]byte](...) instead
+// If you would prefer to raise errors on strings, use mapreader.BytesStrictErr instead
 func BytesErr(source map[string]any, path string) ([]byte, error) {
-	value, err := GetErr[any](source, path)
+	return get(source, path, &defaultOptions, asBytes)
+}
+
+// BytesBase64 returns the decoded bytes of the base64 string found at the given lookup path, ignoring any errors
+//
+// If any error is encountered, it returns nil.
+// Use mapreader.BytesBase64Err if you would like errors to be returned
+func BytesBase64(source map[string]any, path string) []byte {
+	return withoutError(BytesBase64Err(source, path))
+}
+
+// BytesBase64Default returns the decoded bytes of the base64 string found at the given lookup path, or the default value
+//
+// The default is only returned for values that would otherwise error/aren't set.
+func BytesBase64Default(source map[string]any, path string, d []byte) []byte {
+	result, err := BytesBase64Err(source, path)
 	if err != nil {
-		return nil, err
+		return d
 	}
 
-	switch v := value.(type) {
-	case string:
-		return []byte(v), nil
-	case []byte:
-		return v, nil
-	default:
-		return nil, fmt.Errorf("%w: %v cannot be converted to []byte", ErrUnableToConvert, value)
+	return result
+}
+
+// BytesBase64DefaultFunc returns the decoded bytes of the base64 string found at the given lookup path, or the result of calling the default function
+func BytesBase64DefaultFunc(source map[string]any, path string, d func() []byte) []byte {
+	result, err := BytesBase64Err(source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// BytesBase64DefaultOK returns the decoded bytes of the base64 string found at the given lookup path, or the default value, reporting whether the default was used
+func BytesBase64DefaultOK(source map[string]any, path string, d []byte) ([]byte, bool) {
+	result, err := BytesBase64Err(source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// BytesBase64Err returns the decoded bytes of the base64 string found at the given lookup path, or returns an error
+//
+// Use mapreader.BytesBase64 if you would like to ignore errors
+// String values are decoded using either the standard or the URL safe alphabet, with or without padding,
+// returning ErrUnableToConvert if they aren't valid base64. Line breaks within the value are ignored.
+// Values which are already a []byte are returned as they are.
+func BytesBase64Err(source map[string]any, path string) ([]byte, error) {
+	return get(source, path, &defaultOptions, asBase64Bytes)
+}
+
+// BytesStrict returns the []byte value found at the given lookup path, ignoring any errors
+//
+// If any error is encountered, it returns the nil value.
+// Use mapreader.BytesStrictErr if you would like errors to be returned
+// Unlike mapreader.Bytes, string values are not coerced into []byte.
+func BytesStrict(source map[string]any, path string) []byte {
+	return withoutError(BytesStrictErr(source, path))
+}
+
+// BytesStrictDefault returns the []byte value found at the given lookup path, or the default value
+//
+// The default is only returned for values that would otherwise error/aren't set.
+// If a valid nil value is explicitly set, that will be returned instead
+func BytesStrictDefault(source map[string]any, path string, d []byte) []byte {
+	result, err := BytesStrictErr(source, path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// BytesStrictDefaultFunc returns the []byte value found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func BytesStrictDefaultFunc(source map[string]any, path string, d func() []byte) []byte {
+	result, err := BytesStrictErr(source, path)
+	if err != nil {
+		return d()
 	}
+
+	return result
+}
+
+// BytesStrictDefaultOK returns the []byte value found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func BytesStrictDefaultOK(source map[string]any, path string, d []byte) ([]byte, bool) {
+	result, err := BytesStrictErr(source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// BytesStrictErr returns the []byte value found at the given lookup path, or returns an error
+//
+// Use mapreader.BytesStrict if you would like to ignore errors
+// Unlike mapreader.BytesErr, string values are not coerced into []byte, and return ErrUnexpectedType.
+func BytesStrictErr(source map[string]any, path string) ([]byte, error) {
+	return get(source, path, &defaultOptions, asType[[]byte])
 }
 
 // Float64 returns the numeric value found at the given lookup path as a float64, ignoring any errors
@@ -183,6 +464,26 @@ func Float64Default(source map[string]any, path string, d float64) float64 {
 	return result
 }
 
+// Float64DefaultFunc returns the numeric value found at the given lookup path, or the result of calling the default function
+func Float64DefaultFunc(source map[string]any, path string, d func() float64) float64 {
+	result, err := Float64Err(source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// Float64DefaultOK returns the numeric value found at the given lookup path, or the default value, reporting whether the default was used
+func Float64DefaultOK(source map[string]any, path string, d float64) (float64, bool) {
+	result, err := Float64Err(source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
 // Float64Err returns the numeric value found at the given lookup path as a float64, or returns an error
 //
 // Use mapreader.Float64 if you would like to ignore errors
@@ -214,6 +515,26 @@ func IntDefault(source map[string]any, path string, d int) int {
 	return result
 }
 
+// IntDefaultFunc returns the numeric value found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func IntDefaultFunc(source map[string]any, path string, d func() int) int {
+	result, err := IntErr(source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// IntDefaultOK returns the numeric value found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func IntDefaultOK(source map[string]any, path string, d int) (int, bool) {
+	result, err := IntErr(source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
 // IntErr returns the numeric value found at the given lookup path as a float64, or returns an error
 //
 // Use mapreader.Int if you would like to ignore errors
@@ -244,17 +565,44 @@ func SliceDefault[V any](source map[string]any, path string, d []V) []V {
 	return result
 }
 
+// SliceDefaultFunc returns the slice value found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func SliceDefaultFunc[V any](source map[string]any, path string, d func() []V) []V {
+	result, err := SliceErr[V](source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// SliceDefaultOK returns the slice value found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func SliceDefaultOK[V any](source map[string]any, path string, d []V) ([]V, bool) {
+	result, err := SliceErr[V](source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
 // SliceErr returns the a slice found at the given lookup path with elements asserted to the given type, or returns an error
 //
 // Conversion of element types is via a simple type assertion, with no attempt to coerce
+// A slice that's already a []V (e.g. in a source built in Go) is returned as is, sharing its backing array,
+// while other typed slices such as []map[string]any have each of their elements asserted.
 // Use mapreader.Slice if you would like to ignore errors
 func SliceErr[V any](source map[string]any, path string) ([]V, error) {
-	result, err := GetErr[[]any](source, path)
-	if err != nil {
-		return nil, err
-	}
+	return get(source, path, &defaultOptions, asSliceType[V])
+}
 
-	return asSliceType[V](result)
+// SliceInto fetches the slice found at the given lookup path into dst, reusing its backing array, or returns an error
+//
+// Any existing contents of dst are overwritten, and the (potentially grown) slice is returned.
+// This avoids allocating a new slice per call in hot loops, e.g. buf, err = SliceInto(source, path, buf)
+// On error, dst is returned with a length of zero.
+// Conversion of element types is via a simple type assertion, with no attempt to coerce
+func SliceInto[V any](source map[string]any, path string, dst []V) ([]V, error) {
+	return sliceInto(source, path, &defaultOptions, dst)
 }
 
 // Str returns the string value found at the given lookup path, ignoring any errors
@@ -273,6 +621,26 @@ func StrDefault(source map[string]any, path string, d string) string {
 	return GetDefault(source, path, d)
 }
 
+// StrDefaultFunc returns the string value found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func StrDefaultFunc(source map[string]any, path string, d func() string) string {
+	result, err := StrErr(source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// StrDefaultOK returns the string value found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func StrDefaultOK(source map[string]any, path string, d string) (string, bool) {
+	result, err := StrErr(source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
 // StrErr returns the string value found at the given lookup path, or returns an error
 //
 // Use mapreader.Str if you would like to ignore errors
@@ -280,20 +648,21 @@ func StrErr(source map[string]any, path string) (string, error) {
 	return GetErr[string](source, path)
 }
 
-// Map returns the a map found at the given lookup path with elements asserted to the given type, ignoring any errors
+// StrLenient returns the string value found at the given lookup path, ignoring any errors
 //
-// Conversion of element types is via a simple type assertion, with no attempt to coerce
-// Use mapreader.MapErr if you would like errors to be returned
-func Map[V any](source map[string]any, path string) map[string]V {
-	return withoutError(MapErr[V](source, path))
+// If any error is encountered, it returns the empty string.
+// Use mapreader.StrLenientErr if you would like errors to be returned
+// In addition to strings, it accepts values implementing fmt.Stringer or error, using their textual form.
+func StrLenient(source map[string]any, path string) string {
+	return withoutError(StrLenientErr(source, path))
 }
 
-// MapDefault returns the map value found at the given lookup path, or the default value
+// StrLenientDefault returns the string value found at the given lookup path, or the default value
 //
 // The default is only returned for values that would otherwise error/aren't set.
 // If a valid nil value is explicitly set, that will be returned instead
-func MapDefault[V any](source map[string]any, path string, d map[string]V) map[string]V {
-	result, err := MapErr[V](source, path)
+func StrLenientDefault(source map[string]any, path string, d string) string {
+	result, err := StrLenientErr(source, path)
 	if err != nil {
 		return d
 	}
@@ -301,32 +670,165 @@ func MapDefault[V any](source map[string]any, path string, d map[string]V) map[s
 	return result
 }
 
-// MapErr returns the a map found at the given lookup path with elements asserted to the given type, or returns an error
-//
-// Conversion of element types is via a simple type assertion, with no attempt to coerce
-// Use mapreader.Map if you would like to ignore errors
-func MapErr[V any](source map[string]any, path string) (map[string]V, error) {
-	result, err := GetErr[map[string]any](source, path)
+// StrLenientDefaultFunc returns the string value found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func StrLenientDefaultFunc(source map[string]any, path string, d func() string) string {
+	result, err := StrLenientErr(source, path)
 	if err != nil {
-		return nil, err
+		return d()
 	}
 
-	return asMapType[V](result)
+	return result
 }
 
-// Number returns the numeric value found at the given lookup path, ignoring any errors
-//
-// If any error is encountered, it returns the nil value for the specific numeric type.
-// Use mapreader.NumberErr if you would like errors to be returned
-//
-// All numeric types are supported, with the exception of complex numbers and uintptr
-// It will attempt to convert the number to the requested type, if it can do so whilst maintaining equality.
-// e.g. Number[int](source, path) would convert a float64(1) to int(1), but would return 0 for float64(1.5)
-func Number[R number](source map[string]any, path string) R {
-	return withoutError(NumberErr[R](source, path))
+// StrLenientDefaultOK returns the string value found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func StrLenientDefaultOK(source map[string]any, path string, d string) (string, bool) {
+	result, err := StrLenientErr(source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
 }
 
-// NumberDefault returns the map value found at the given lookup path, or the default value
+// StrLenientErr returns the string value found at the given lookup path, or returns an error
+//
+// Use mapreader.StrLenient if you would like to ignore errors
+// In addition to strings, it accepts values implementing fmt.Stringer or error, using their textual form.
+// If you would prefer to raise errors on these, use mapreader.StrErr instead
+func StrLenientErr(source map[string]any, path string) (string, error) {
+	return get(source, path, &defaultOptions, asStrLenient)
+}
+
+// StrCoerce returns the value found at the given lookup path as a string, ignoring any errors
+//
+// If any error is encountered, it returns the empty string.
+// Use mapreader.StrCoerceErr if you would like errors to be returned
+func StrCoerce(source map[string]any, path string) string {
+	return withoutError(StrCoerceErr(source, path))
+}
+
+// StrCoerceDefault returns the value found at the given lookup path, or the default value
+//
+// The default is only returned for values that would otherwise error/aren't set.
+func StrCoerceDefault(source map[string]any, path string, d string) string {
+	result, err := StrCoerceErr(source, path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// StrCoerceDefaultFunc returns the value found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func StrCoerceDefaultFunc(source map[string]any, path string, d func() string) string {
+	result, err := StrCoerceErr(source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// StrCoerceDefaultOK returns the value found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func StrCoerceDefaultOK(source map[string]any, path string, d string) (string, bool) {
+	result, err := StrCoerceErr(source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// StrCoerceErr returns the value found at the given lookup path as a string, or returns an error
+//
+// Use mapreader.StrCoerce if you would like to ignore errors
+// Unlike mapreader.StrErr, numbers and booleans are converted to their textual form (e.g. "42" or "true"),
+// as are the values accepted by mapreader.StrLenientErr, such as json.Number. Maps, slices and null still error.
+func StrCoerceErr(source map[string]any, path string) (string, error) {
+	return get(source, path, &defaultOptions, asStrCoerce)
+}
+
+// StrSliceFlexible returns the strings found at the given lookup path, or returns an error
+//
+// The value may be either a single string or a list of strings, and is always returned as a slice.
+// This suits fields like aud, tags or emails that appear in both forms.
+func StrSliceFlexible(source map[string]any, path string) ([]string, error) {
+	return get(source, path, &defaultOptions, asStrOrStrSlice)
+}
+
+// Map returns the a map found at the given lookup path with elements asserted to the given type, ignoring any errors
+//
+// Conversion of element types is via a simple type assertion, with no attempt to coerce
+// Use mapreader.MapErr if you would like errors to be returned
+func Map[V any](source map[string]any, path string) map[string]V {
+	return withoutError(MapErr[V](source, path))
+}
+
+// MapDefault returns the map value found at the given lookup path, or the default value
+//
+// The default is only returned for values that would otherwise error/aren't set.
+// If a valid nil value is explicitly set, that will be returned instead
+func MapDefault[V any](source map[string]any, path string, d map[string]V) map[string]V {
+	result, err := MapErr[V](source, path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// MapDefaultFunc returns the map value found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func MapDefaultFunc[V any](source map[string]any, path string, d func() map[string]V) map[string]V {
+	result, err := MapErr[V](source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// MapDefaultOK returns the map value found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func MapDefaultOK[V any](source map[string]any, path string, d map[string]V) (map[string]V, bool) {
+	result, err := MapErr[V](source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// MapErr returns the a map found at the given lookup path with elements asserted to the given type, or returns an error
+//
+// Conversion of element types is via a simple type assertion, with no attempt to coerce
+// A map that's already a map[string]V (e.g. in a source built in Go) is returned as is, while other maps
+// with string keys, such as map[string]int requested as map[string]any, have each of their elements asserted.
+// Use mapreader.Map if you would like to ignore errors
+func MapErr[V any](source map[string]any, path string) (map[string]V, error) {
+	return get(source, path, &defaultOptions, asMapType[V])
+}
+
+// MapInto fetches the map found at the given lookup path into dst, or returns an error
+//
+// Any existing contents of dst are cleared first, allowing the same map to be reused across calls in hot loops.
+// On error, dst is left empty.
+// Conversion of element types is via a simple type assertion, with no attempt to coerce
+func MapInto[V any](source map[string]any, path string, dst map[string]V) error {
+	return mapInto(source, path, &defaultOptions, dst)
+}
+
+// Number returns the numeric value found at the given lookup path, ignoring any errors
+//
+// If any error is encountered, it returns the nil value for the specific numeric type.
+// Use mapreader.NumberErr if you would like errors to be returned
+//
+// All numeric types are supported, with the exception of complex numbers and uintptr
+// It will attempt to convert the number to the requested type, if it can do so whilst maintaining equality.
+// e.g. Number[int](source, path) would convert a float64(1) to int(1), but would return 0 for float64(1.5)
+func Number[R number](source map[string]any, path string) R {
+	return withoutError(NumberErr[R](source, path))
+}
+
+// NumberDefault returns the map value found at the given lookup path, or the default value
 //
 // The default is only returned for values that would otherwise error/aren't set.
 // If a valid nil value is explicitly set, that will be returned instead
@@ -339,6 +841,26 @@ func NumberDefault[R number](source map[string]any, path string, d R) R {
 	return result
 }
 
+// NumberDefaultFunc returns the numeric value found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func NumberDefaultFunc[R number](source map[string]any, path string, d func() R) R {
+	result, err := NumberErr[R](source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// NumberDefaultOK returns the numeric value found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func NumberDefaultOK[R number](source map[string]any, path string, d R) (R, bool) {
+	result, err := NumberErr[R](source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
 // NumberErr returns the numeric value found at the given lookup path, or returns an error
 //
 // Use mapreader.Number if you would like to ignore errors
@@ -347,33 +869,299 @@ func NumberDefault[R number](source map[string]any, path string, d R) R {
 // It will attempt to convert the number to the requested type, if it can do so whilst maintaining equality.
 // e.g. Number[int](source, path) would convert a float64(1) to int(1), but would return an error for float64(1.5)
 func NumberErr[R number](source map[string]any, path string) (R, error) {
-	result, err := GetErr[any](source, path)
+	return get(source, path, &defaultOptions, asNumber[R](&defaultOptions))
+}
+
+// NumberSlice returns the slice found at the given lookup path with elements converted to the given numeric type, ignoring any errors
+//
+// If any error is encountered, it returns nil.
+// Use mapreader.NumberSliceErr if you would like errors to be returned
+func NumberSlice[R number](source map[string]any, path string) []R {
+	return withoutError(NumberSliceErr[R](source, path))
+}
+
+// NumberSliceDefault returns the numeric slice found at the given lookup path, or the default value
+//
+// The default is only returned for values that would otherwise error/aren't set.
+// If a valid nil value is explicitly set, that will be returned instead
+func NumberSliceDefault[R number](source map[string]any, path string, d []R) []R {
+	result, err := NumberSliceErr[R](source, path)
 	if err != nil {
-		return *new(R), err
+		return d
 	}
 
-	return asNumberType[R](result)
+	return result
 }
 
-// asMapType converts a map[string]any into map[string]R (R being target type)
+// NumberSliceDefaultFunc returns the numeric slice found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func NumberSliceDefaultFunc[R number](source map[string]any, path string, d func() []R) []R {
+	result, err := NumberSliceErr[R](source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// NumberSliceDefaultOK returns the numeric slice found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func NumberSliceDefaultOK[R number](source map[string]any, path string, d []R) ([]R, bool) {
+	result, err := NumberSliceErr[R](source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// NumberSliceErr returns the slice found at the given lookup path with elements converted to the given numeric type, or returns an error
 //
-// Conversion is via a simple type assertion with no attempt to coerce
-func asMapType[R any](in map[string]any) (map[string]R, error) {
-	result := make(map[string]R)
-	for i, v := range in {
-		value, ok := v.(R)
+// Use mapreader.NumberSlice if you would like to ignore errors
+// Unlike mapreader.SliceErr, each element is converted as mapreader.NumberErr would, whilst maintaining equality.
+// This suits sources mixing numeric types in the same slice, e.g. Firestore stores 1 as int64 but 1.5 as float64.
+func NumberSliceErr[R number](source map[string]any, path string) ([]R, error) {
+	return get(source, path, &defaultOptions, asNumberSlice[R](&defaultOptions))
+}
+
+// appendSliceElements appends the elements of a slice of any/interface{} type to dst, asserted to the desired type
+//
+// Conversion is via a simple type assertion with no attempt to coerce.
+// On error, dst is returned unchanged.
+func appendSliceElements[I any](dst []I, in []any) ([]I, error) {
+	result := slices.Grow(dst, len(in))
+	for _, v := range in {
+		value, ok := v.(I)
 		if !ok {
-			return nil, fmt.Errorf("%w: %v cannot be converted to %T", ErrUnableToConvert, v, value)
+			return dst, fmt.Errorf("%w: %v cannot be converted to %T", ErrUnableToConvert, v, value)
 		}
-		result[i] = value
+		result = append(result, value)
+	}
+
+	return result, nil
+}
+
+// anyMapValue converts a typed map with string keys into t, where t is map[string]any or a named equivalent
+//
+// This covers maps built in Go, such as map[string]int or map[string]string.
+func anyMapValue(in any, t reflect.Type) (reflect.Value, bool) {
+	if t.Kind() != reflect.Map || !reflect.TypeFor[map[string]any]().ConvertibleTo(t) {
+		return reflect.Value{}, false
+	}
+
+	v := reflect.ValueOf(in)
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		return reflect.Value{}, false
+	}
+
+	m := make(map[string]any, v.Len())
+	for iter := v.MapRange(); iter.Next(); {
+		m[iter.Key().String()] = iter.Value().Interface()
+	}
+
+	return reflect.ValueOf(m).Convert(t), true
+}
+
+// anySliceValue converts a typed slice into t, where t is []any or a named equivalent
+//
+// This covers slices such as the []map[string]any table arrays produced by TOML decoders.
+func anySliceValue(in any, t reflect.Type) (reflect.Value, bool) {
+	if t.Kind() != reflect.Slice || t.Elem() != reflect.TypeFor[any]() {
+		return reflect.Value{}, false
+	}
+
+	v := reflect.ValueOf(in)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array || v.Type().Elem().Kind() == reflect.Uint8 {
+		return reflect.Value{}, false
+	}
+
+	s := make([]any, v.Len())
+	for i := range s {
+		s[i] = v.Index(i).Interface()
+	}
+
+	return reflect.ValueOf(s).Convert(t), true
+}
+
+// asBytes converts a []byte or string value into []byte
+func asBytes(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	default:
+		if result, ok := asNativeType[[]byte](value); ok {
+			return result, nil
+		}
+
+		return nil, fmt.Errorf("%w: %v cannot be converted to []byte", ErrUnableToConvert, value)
+	}
+}
+
+// asBase64Bytes decodes a base64 string, in either the standard or URL safe alphabet, into []byte
+func asBase64Bytes(value any) ([]byte, error) {
+	s, ok := value.(string)
+	if !ok {
+		if result, ok := asNativeType[[]byte](value); ok {
+			return result, nil
+		}
+
+		return nil, fmt.Errorf("%w: expected a base64 string, got %T", ErrUnexpectedType, value)
+	}
+
+	s = strings.TrimRight(strings.NewReplacer("\r", "", "\n", "").Replace(s), "=")
+	encoding := base64.RawStdEncoding
+	if strings.ContainsAny(s, "-_") {
+		encoding = base64.RawURLEncoding
+	}
+
+	result, err := encoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnableToConvert, err)
+	}
+
+	return result, nil
+}
+
+// asLen returns the number of elements of a slice, keys of a map, or runes of a string
+func asLen(value any) (int, error) {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array:
+		return v.Len(), nil
+	case reflect.String:
+		return utf8.RuneCountInString(v.String()), nil
+	default:
+		return 0, fmt.Errorf("%w: length requires a slice, map or string but got '%T'", ErrUnexpectedType, value)
+	}
+}
+
+// asMapType converts a map[string]any into map[string]R (R being target type)
+//
+// Conversion is via a simple type assertion with no attempt to coerce.
+// Maps that are already of the desired type (or a named type of it) are returned directly, without copying,
+// while other maps with string keys are converted element by element.
+func asMapType[R any](value any) (map[string]R, error) {
+	if result, ok := value.(map[string]R); ok {
+		return result, nil
+	}
+
+	if result, ok := asNativeType[map[string]R](value); ok {
+		return result, nil
+	}
+
+	in, err := asType[map[string]any](value)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]R, len(in))
+	if err := copyMapElements(result, in); err != nil {
+		return nil, err
 	}
 
 	return result, nil
 }
 
+// asType asserts a value to the target type
+//
+// If the assertion fails, string values are unmarshalled into target types implementing encoding.TextUnmarshaler.
+// As a last resort, target types implementing json.Unmarshaler are populated from the JSON encoding of the value.
+func asType[T any](in any) (T, error) {
+	if result, ok := in.(T); ok {
+		return result, nil
+	}
+
+	v, err := asTypeOf(in, reflect.TypeFor[T]())
+	if err != nil {
+		return *new(T), err
+	}
+
+	return v.Interface().(T), nil
+}
+
+// asTypeOf converts a value into the given type following the rules of asType, for types only known at runtime
+func asTypeOf(in any, t reflect.Type) (reflect.Value, error) {
+	if v := reflect.ValueOf(in); v.IsValid() && v.Type().AssignableTo(t) {
+		return v, nil
+	}
+
+	if v, ok := nativeValue(in, t); ok {
+		return v, nil
+	}
+
+	if v, ok := anySliceValue(in, t); ok {
+		return v, nil
+	}
+
+	if v, ok := anyMapValue(in, t); ok {
+		return v, nil
+	}
+
+	if text, ok := in.(string); ok {
+		if target, result, ok := unmarshalTarget(t, reflect.TypeFor[encoding.TextUnmarshaler]()); ok {
+			if err := target.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(text)); err != nil {
+				return reflect.Value{}, fmt.Errorf("%w: %w", ErrUnableToConvert, err)
+			}
+
+			return result(), nil
+		}
+	}
+
+	if target, result, ok := unmarshalTarget(t, reflect.TypeFor[json.Unmarshaler]()); ok {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%w: %w", ErrUnableToConvert, err)
+		}
+
+		if err := target.Interface().(json.Unmarshaler).UnmarshalJSON(data); err != nil {
+			return reflect.Value{}, fmt.Errorf("%w: %w", ErrUnableToConvert, err)
+		}
+
+		return result(), nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("%w: '%T'", ErrUnexpectedType, in)
+}
+
+// asNativeType converts Go values of a pointer or named type into T, where the underlying kind already matches
+//
+// This covers values placed into the source by Go code rather than decoded from JSON,
+// e.g. a *time.Time requested as time.Time, or a named string type requested as a string.
+func asNativeType[T any](in any) (T, bool) {
+	v, ok := nativeValue(in, reflect.TypeFor[T]())
+	if !ok {
+		return *new(T), false
+	}
+
+	return v.Interface().(T), true
+}
+
+// nativeValue converts Go values of a pointer or named type into t, where the underlying kind already matches
+func nativeValue(in any, t reflect.Type) (reflect.Value, bool) {
+	v := reflect.ValueOf(in)
+	if v.Kind() == reflect.Pointer && !v.IsNil() {
+		if v.Elem().Type().AssignableTo(t) {
+			return v.Elem(), true
+		}
+		v = v.Elem()
+	}
+
+	if !v.IsValid() || t.Kind() == reflect.Interface || v.Kind() != t.Kind() || !v.Type().ConvertibleTo(t) {
+		return reflect.Value{}, false
+	}
+
+	return v.Convert(t), true
+}
+
 // asNumberType converts a given numeric value to an equal value in the target type
 //
 // If the result is not equal in value to the input, an error will be returned.
+// Named numeric types (e.g. time.Duration) and pointers to numbers are also accepted, and json.Number values
+// (as decoded with json.Decoder.UseNumber) are parsed with full integer precision.
 func asNumberType[R number](in any) (R, error) {
 	switch r := in.(type) {
 	case float64:
@@ -402,25 +1190,194 @@ func asNumberType[R number](in any) (R, error) {
 		return convertNumber[R](r)
 	case uintptr:
 		return convertNumber[R](r)
+	case json.Number:
+		return parseNumber[R](r.String())
 	default:
+		v := reflect.ValueOf(in)
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return convertNumber[R](v.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return convertNumber[R](v.Uint())
+		case reflect.Float32, reflect.Float64:
+			return convertNumber[R](v.Float())
+		case reflect.Pointer:
+			if !v.IsNil() {
+				return asNumberType[R](v.Elem().Interface())
+			}
+		}
+
 		return 0, fmt.Errorf("%w: %T is not a supported numeric type", ErrUnexpectedType, r)
 	}
 }
 
-// asSlice type converts a slice of any/interface{} type into a slice of the desired type
-//
-// Conversion is via a simple type assertion with no attempt to coerce
-func asSliceType[I any](in []any) ([]I, error) {
-	result := make([]I, len(in))
-	for i, v := range in {
-		value, ok := v.(I)
-		if !ok {
-			return nil, fmt.Errorf("%w: %v cannot be converted to %T", ErrUnableToConvert, v, value)
-		}
-		result[i] = value
+// asNumber returns the conversion used by the numeric getters, which also parses numeric strings if the options allow
+func asNumber[R number](opts *Options) func(any) (R, error) {
+	if !opts.ParseNumbers {
+		return asNumberType[R]
 	}
 
-	return result, nil
+	return func(in any) (R, error) {
+		if s, ok := in.(string); ok {
+			return parseNumber[R](strings.TrimSpace(s))
+		}
+
+		return asNumberType[R](in)
+	}
+}
+
+// asNumberSlice returns the conversion used by the numeric slice getters, converting each element as by asNumber
+func asNumberSlice[R number](opts *Options) func(any) ([]R, error) {
+	convert := asNumber[R](opts)
+	return func(value any) ([]R, error) {
+		in, err := asType[[]any](value)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]R, len(in))
+		for i, v := range in {
+			if result[i], err = convert(v); err != nil {
+				return nil, fmt.Errorf("index %d: %w", i, err)
+			}
+		}
+
+		return result, nil
+	}
+}
+
+// asSlice type converts a slice of any/interface{} type into a slice of the desired type
+//
+// Conversion is via a simple type assertion with no attempt to coerce.
+// Slices that are already of the desired type (or a named type of it) are returned directly, without copying,
+// while other typed slices are converted element by element.
+func asSliceType[I any](value any) ([]I, error) {
+	if result, ok := value.([]I); ok {
+		return result, nil
+	}
+
+	if result, ok := asNativeType[[]I](value); ok {
+		return result, nil
+	}
+
+	in, err := asType[[]any](value)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := appendSliceElements(make([]I, 0, len(in)), in)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// asStrLenient converts a string, fmt.Stringer or error value into a string
+func asStrLenient(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case fmt.Stringer:
+		return v.String(), nil
+	case error:
+		return v.Error(), nil
+	default:
+		if result, ok := asNativeType[string](value); ok {
+			return result, nil
+		}
+
+		return "", fmt.Errorf("%w: %v cannot be converted to string", ErrUnableToConvert, value)
+	}
+}
+
+// asBoolLenient converts a boolean, or a common textual or numeric form of one, into a bool
+func asBoolLenient(value any) (bool, error) {
+	if b, ok := value.(bool); ok {
+		return b, nil
+	}
+
+	if s, ok := value.(string); ok {
+		switch strings.ToLower(strings.TrimSpace(s)) {
+		case "true", "yes", "on", "1":
+			return true, nil
+		case "false", "no", "off", "0":
+			return false, nil
+		default:
+			return false, fmt.Errorf("%w: %q is not a recognised boolean", ErrUnableToConvert, s)
+		}
+	}
+
+	if n, err := asNumberType[int](value); err == nil && (n == 0 || n == 1) {
+		return n == 1, nil
+	} else if err == nil || errors.Is(err, ErrUnableToConvert) {
+		return false, fmt.Errorf("%w: %v is not a recognised boolean", ErrUnableToConvert, value)
+	}
+
+	if result, ok := asNativeType[bool](value); ok {
+		return result, nil
+	}
+
+	return false, fmt.Errorf("%w: expected a boolean, got %T", ErrUnexpectedType, value)
+}
+
+// asStrCoerce converts a scalar value into its textual form, formatting numbers and booleans as strings
+func asStrCoerce(value any) (string, error) {
+	if result, err := asStrLenient(value); err == nil {
+		return result, nil
+	}
+
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		bits := v.Type().Bits()
+		if f := math.Abs(v.Float()); f != 0 && (f < 1e-6 || f >= 1e21) {
+			return strconv.FormatFloat(v.Float(), 'g', -1, bits), nil
+		}
+
+		return strconv.FormatFloat(v.Float(), 'f', -1, bits), nil
+	default:
+		return "", fmt.Errorf("%w: %T cannot be converted to string", ErrUnexpectedType, value)
+	}
+}
+
+// asStrOrStrSlice converts either a single string or a list of strings into a slice of strings
+func asStrOrStrSlice(value any) ([]string, error) {
+	if s, ok := value.(string); ok {
+		return []string{s}, nil
+	}
+
+	if s, ok := value.([]string); ok {
+		return s, nil
+	}
+
+	return asSliceType[string](value)
+}
+
+// copyMapElements copies the elements of a map[string]any into dst, asserted to the desired type
+//
+// Conversion is via a simple type assertion with no attempt to coerce.
+// On error, dst may have been partially populated.
+func copyMapElements[R any](dst map[string]R, in map[string]any) error {
+	for k, v := range in {
+		value, ok := v.(R)
+		if !ok {
+			return fmt.Errorf("%w: %v cannot be converted to %T", ErrUnableToConvert, v, value)
+		}
+		dst[k] = value
+	}
+
+	return nil
 }
 
 // convertNumber generically converts from one numeric type to another (excluding complex number types)
@@ -440,6 +1397,519 @@ func convertNumber[R, I number](in I) (R, error) {
 	)
 }
 
+// descend descends a single level into the current value using the given key, without custom unwrapping
+//
+// Literal keys, from escaped or quoted path segments, are never treated as selectors, ranges or negative indexes.
+func descend(current any, key string, literal bool, opts *Options) (any, error) {
+	switch c := current.(type) {
+	case map[string]any:
+		v, ok := c[key]
+		if !ok {
+			if folded, ok := foldKey(key, c, opts); ok {
+				return c[folded], nil
+			}
+
+			if union, ok := unionValue(c); opts.UnwrapUnions && ok {
+				return stepSegment(union, key, literal, opts)
+			}
+
+			return nil, keyNotFoundError(key, c, opts)
+		}
+
+		return v, nil
+	case []any:
+		if field, value, ok := keySelector(key); ok && !literal {
+			return selectByKey(len(c), func(i int) any { return c[i] }, field, value, opts)
+		}
+
+		if start, end, ok := sliceRange(key, len(c)); ok && !literal {
+			return c[start:end], nil
+		}
+
+		if opts.UnwrapSingletonLists && !isSegmentIndex(key, literal) {
+			if len(c) == 1 {
+				return stepSegment(c[0], key, literal, opts)
+			}
+
+			if v, ok := blockValue(c, key, literal, opts); ok {
+				return v, nil
+			}
+		}
+
+		i, err := segmentIndex(key, literal, len(c))
+		if err != nil {
+			return nil, err
+		}
+
+		return c[i], nil
+	default:
+		if unwrapped, ok := wellKnownValue(current); ok {
+			return stepSegment(unwrapped, key, literal, opts)
+		}
+
+		if decoded, ok := jsonTextValue(current); ok {
+			return stepSegment(decoded, key, literal, opts)
+		}
+
+		return reflectStep(current, key, literal, opts)
+	}
+}
+
+// get looks up the value at the given path and converts it into the result type
+//
+// Wrapped values that fail conversion are unwrapped and converted again, e.g. a protobuf Timestamp into time.Time.
+func get[R any](source map[string]any, path string, opts *Options, convert func(any) (R, error)) (R, error) {
+	value, err := lookup(source, path, opts)
+	if err != nil {
+		return *new(R), lookupError(path, err, opts)
+	}
+
+	result, err := convertValue(value, opts, convert)
+	if err != nil {
+		return result, lookupError(path, err, opts)
+	}
+
+	return result, nil
+}
+
+// convertValue converts a found value, retrying with each unwrapped form of it if the conversion fails
+//
+// Failures to convert an explicit null also wrap ErrNilValue, so they can be told apart from type mismatches.
+func convertValue[R any](value any, opts *Options, convert func(any) (R, error)) (R, error) {
+	result, err := convert(value)
+	if err != nil {
+		if value == nil {
+			return result, fmt.Errorf("%w: %w", ErrNilValue, err)
+		}
+
+		for unwrapped, ok := unwrap(value, opts); ok; unwrapped, ok = unwrap(unwrapped, opts) {
+			if result, err := convert(unwrapped); err == nil {
+				return result, nil
+			}
+		}
+	}
+
+	return result, err
+}
+
+// getInto assigns the value found at the given path into dst, leaving it untouched on error
+func getInto[T any](source map[string]any, path string, opts *Options, dst *T) error {
+	result, err := get(source, path, opts, asType[T])
+	if err != nil {
+		return err
+	}
+
+	*dst = result
+	return nil
+}
+
+// getMany looks up the values at each of the given paths in order, stopping at the first error
+func getMany[T any](source map[string]any, paths []string, opts *Options) ([]T, error) {
+	result := make([]T, len(paths))
+	for i, path := range paths {
+		value, err := get(source, path, opts, asType[T])
+		if err != nil {
+			return nil, fmt.Errorf("path '%s': %w", path, err)
+		}
+		result[i] = value
+	}
+
+	return result, nil
+}
+
+// getManyPartial looks up the values at each of the given paths in order, collecting any errors
+func getManyPartial[T any](source map[string]any, paths []string, opts *Options) ([]T, error) {
+	result := make([]T, len(paths))
+	var errs []error
+	for i, path := range paths {
+		value, err := get(source, path, opts, asType[T])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("path '%s': %w", path, err))
+		}
+		result[i] = value
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// getFirst looks up each of the given paths in order, returning the first value that converts successfully
+func getFirst[T any](source map[string]any, paths []string, opts *Options) (T, error) {
+	errs := make([]error, 0, len(paths))
+	for _, path := range paths {
+		value, err := get(source, path, opts, asType[T])
+		if err == nil {
+			return value, nil
+		}
+		errs = append(errs, fmt.Errorf("path '%s': %w", path, err))
+	}
+
+	if len(errs) == 0 {
+		return *new(T), fmt.Errorf("%w: no paths given", ErrInvalidPath)
+	}
+
+	return *new(T), errors.Join(errs...)
+}
+
+// getNullable looks up the value at the given path, distinguishing absent and null values
+func getNullable[T any](source map[string]any, path string, opts *Options) (value T, present bool, isNull bool, err error) {
+	value, err = get(source, path, opts, func(v any) (T, error) {
+		present = true
+		if v == nil {
+			isNull = true
+			return *new(T), nil
+		}
+
+		return asType[T](v)
+	})
+
+	if errors.Is(err, ErrKeyNotFound) || errors.Is(err, ErrIndexOutOfBounds) {
+		return value, false, false, nil
+	}
+
+	return value, present, isNull, err
+}
+
+// has reports whether the given path resolves, returning any error other than the value being missing
+func has(source map[string]any, path string, opts *Options) (bool, error) {
+	_, err := lookup(source, path, opts)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, ErrKeyNotFound) || errors.Is(err, ErrIndexOutOfBounds):
+		return false, nil
+	default:
+		return false, lookupError(path, err, opts)
+	}
+}
+
+// isNull reports whether the given path resolves to an explicit null
+func isNull(source map[string]any, path string, opts *Options) bool {
+	value, err := lookup(source, path, opts)
+	return err == nil && value == nil
+}
+
+// isIndex reports whether a path segment is an integer slice index
+func isIndex(key string) bool {
+	_, err := strconv.Atoi(key)
+	return err == nil
+}
+
+// lookup returns the value found at the given path, with any modifiers at the end of the path applied to it
+func lookup(source map[string]any, path string, opts *Options) (any, error) {
+	path, modifiers := cutModifiers(path)
+
+	value, err := lookupPath(source, path, opts)
+	if err != nil || len(modifiers) == 0 {
+		return value, err
+	}
+
+	return applyModifiers(value, modifiers, opts)
+}
+
+// lookupPath traverses the source one path segment at a time, returning the value found at the end of the path
+func lookupPath(source map[string]any, path string, opts *Options) (any, error) {
+	var current any = source
+
+	for remaining := path; ; {
+		key, rest, more, literal := cutLiteralSegment(remaining)
+		if remaining == "#" {
+			return length(current, opts)
+		}
+
+		next, err := stepSegment(current, key, literal, opts)
+		// A final key against a value that can't be traversed has always returned that value
+		if !more && errors.Is(err, ErrEndOfNestedStructures) {
+			return current, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		current = next
+
+		if !more {
+			return foundValue(current, opts), nil
+		}
+
+		current = traversable(current, opts)
+		remaining = rest
+	}
+}
+
+// traversable returns the value to step into with the next path segment, seeing through attribute values if enabled
+func traversable(value any, opts *Options) any {
+	if unwrapped, ok := attributeValue(value); opts.UnwrapAttributeValues && ok {
+		return unwrapped
+	}
+
+	return value
+}
+
+// foundValue returns the value found at the end of a path, with any attribute values within it unwrapped if enabled
+func foundValue(value any, opts *Options) any {
+	if opts.UnwrapAttributeValues {
+		return plainAttributeValue(value)
+	}
+
+	return value
+}
+
+// length returns the number of elements of a slice, or keys of a map, as found by a final "#" path segment
+func length(value any, opts *Options) (int, error) {
+	for current, ok := value, true; ok; current, ok = unwrap(current, opts) {
+		v := reflect.ValueOf(current)
+		for v.Kind() == reflect.Pointer && !v.IsNil() {
+			v = v.Elem()
+		}
+
+		switch v.Kind() {
+		case reflect.Map, reflect.Slice, reflect.Array:
+			return v.Len(), nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: length requires a slice or map but got '%T'", ErrUnexpectedType, value)
+}
+
+// lookupError returns the error for a failed lookup of the given path, allowing the options to replace or format it
+func lookupError(path string, err error, opts *Options) error {
+	if opts.lookupError != nil {
+		err = opts.lookupError(path, err)
+	}
+
+	if opts.ErrorFormatter != nil {
+		return formatError(path, err, opts.ErrorFormatter)
+	}
+
+	return err
+}
+
+// mapInto fetches the map found at the given path into dst, clearing it first
+func mapInto[V any](source map[string]any, path string, opts *Options, dst map[string]V) error {
+	clear(dst)
+
+	in, err := get(source, path, opts, asType[map[string]any])
+	if err != nil {
+		return err
+	}
+
+	if err := copyMapElements(dst, in); err != nil {
+		clear(dst)
+		return err
+	}
+
+	return nil
+}
+
+// sliceIndex parses a path segment as an index into a slice of the given length
+//
+// Negative indexes count back from the end of the slice, so -1 is its last element.
+func sliceIndex(key string, length int) (int, error) {
+	i, err := strconv.Atoi(key)
+	if err != nil {
+		return 0, fmt.Errorf("%w: lookup was '%s'", ErrNonIntegerSliceAccess, key)
+	}
+
+	if i < -length || i > length-1 {
+		return 0, fmt.Errorf("%w: index '%d' but length '%d'", ErrIndexOutOfBounds, i, length)
+	}
+
+	if i < 0 {
+		i += length
+	}
+
+	return i, nil
+}
+
+// segmentIndex parses a path segment as an index into a slice of the given length
+//
+// Literal segments must be plain non-negative integers, so an escaped "-1" never counts back from the end.
+func segmentIndex(key string, literal bool, length int) (int, error) {
+	if literal && !isSegmentIndex(key, literal) {
+		return 0, fmt.Errorf("%w: lookup was '%s'", ErrNonIntegerSliceAccess, key)
+	}
+
+	return sliceIndex(key, length)
+}
+
+// isSegmentIndex returns whether a path segment is an index of a slice, see segmentIndex
+func isSegmentIndex(key string, literal bool) bool {
+	if literal {
+		return key != "" && strings.Trim(key, "0123456789") == ""
+	}
+
+	return isIndex(key)
+}
+
+// foldKey returns the key of the map matching the given key regardless of case, if enabled by the options
+//
+// If several keys match, the first in sorted order is returned so lookups are deterministic.
+func foldKey(key string, m map[string]any, opts *Options) (string, bool) {
+	if !opts.CaseInsensitiveKeys {
+		return "", false
+	}
+
+	var folded string
+	found := false
+	for k := range m {
+		if strings.EqualFold(k, key) && (!found || k < folded) {
+			folded, found = k, true
+		}
+	}
+
+	return folded, found
+}
+
+// isRange returns whether a path segment is a range of a slice
+func isRange(key string) bool {
+	_, _, ok := sliceRange(key, 0)
+	return ok
+}
+
+// sliceRange parses a path segment as a range of a slice of the given length, e.g. "1:4", "2:" or ":-1"
+//
+// As with Python slices, negative bounds count back from the end of the slice,
+// and bounds beyond either end of the slice are clamped to it.
+func sliceRange(key string, length int) (start, end int, ok bool) {
+	from, to, found := strings.Cut(key, ":")
+	if !found {
+		return 0, 0, false
+	}
+
+	bound := func(s string, d int) (int, bool) {
+		if s == "" {
+			return d, true
+		}
+
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return 0, false
+		}
+
+		if i < 0 {
+			i += length
+		}
+
+		return min(max(i, 0), length), true
+	}
+
+	start, okStart := bound(from, 0)
+	end, okEnd := bound(to, length)
+	if !okStart || !okEnd {
+		return 0, 0, false
+	}
+
+	return start, max(start, end), true
+}
+
+// sliceInto fetches the slice found at the given path into dst, reusing its backing array
+func sliceInto[V any](source map[string]any, path string, opts *Options, dst []V) ([]V, error) {
+	in, err := get(source, path, opts, asType[[]any])
+	if err != nil {
+		return dst[:0], err
+	}
+
+	return appendSliceElements(dst[:0], in)
+}
+
+// step descends a single level into the current value using the given key
+//
+// If the current value can't be descended into, any custom unwrappers are tried before giving up.
+func step(current any, key string, opts *Options) (any, error) {
+	return stepSegment(current, key, false, opts)
+}
+
+// stepSegment is step for a path segment, which is looked up as a literal key if it was escaped or quoted
+func stepSegment(current any, key string, literal bool, opts *Options) (any, error) {
+	next, err := descend(current, key, literal, opts)
+	if err != nil {
+		for _, unwrapper := range opts.Unwrappers {
+			if unwrapped, ok := unwrapper(current); ok {
+				return stepSegment(unwrapped, key, literal, opts)
+			}
+		}
+	}
+
+	return next, err
+}
+
+// blockValue returns the value of the key from the first element of a list of blocks that holds it
+//
+// HCL JSON expresses repeated blocks as lists of single key maps, e.g. [{"region": {...}}, {"zone": {...}}].
+func blockValue(blocks []any, key string, literal bool, opts *Options) (any, bool) {
+	for _, block := range blocks {
+		if v, err := stepSegment(block, key, literal, opts); err == nil {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+// unionValue returns the value of a union encoded as a single key map, e.g. {"string": "x"}
+func unionValue(v any) (any, bool) {
+	m, ok := v.(map[string]any)
+	if !ok || len(m) != 1 {
+		return nil, false
+	}
+
+	for _, value := range m {
+		return value, true
+	}
+
+	return nil, false
+}
+
+// unwrap removes a wrapper from a value, where recognised and allowed by the options
+//
+// Well-known protobuf types, MongoDB Extended JSON and jsontext.Value nodes are always unwrapped,
+// whereas unions require Options.UnwrapUnions.
+// Any custom unwrappers from the options are tried last.
+func unwrap(value any, opts *Options) (any, bool) {
+	if unwrapped, ok := wellKnownValue(value); ok {
+		return unwrapped, true
+	}
+
+	if unwrapped, ok := extendedJSONValue(value); ok {
+		return unwrapped, true
+	}
+
+	if decoded, ok := jsonTextValue(value); ok {
+		return decoded, true
+	}
+
+	if union, ok := unionValue(value); opts.UnwrapUnions && ok {
+		return union, true
+	}
+
+	for _, unwrapper := range opts.Unwrappers {
+		if unwrapped, ok := unwrapper(value); ok {
+			return unwrapped, true
+		}
+	}
+
+	return nil, false
+}
+
+// unmarshalTarget returns a decoding target implementing u for the result type t, if t supports it
+//
+// The target is either a *t, or a newly allocated value when t is itself a pointer type.
+// Calling result after the target has been populated returns the decoded value as a t.
+func unmarshalTarget(t, u reflect.Type) (target reflect.Value, result func() reflect.Value, ok bool) {
+	if target = reflect.New(t); target.Type().Implements(u) {
+		return target, target.Elem, true
+	}
+
+	if t.Kind() != reflect.Pointer {
+		return reflect.Value{}, nil, false
+	}
+
+	if target = reflect.New(t.Elem()); !target.Type().Implements(u) {
+		return reflect.Value{}, nil, false
+	}
+
+	return target, func() reflect.Value { return target.Convert(t) }, true
+}
+
 // withoutError is a helper function to silently drop a returned error
 func withoutError[R any](result R, _ error) R {
 	return result
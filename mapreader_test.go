@@ -3,8 +3,11 @@ package mapreader
 import (
 	"encoding/json"
 	"errors"
+	"net/netip"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestGetTypes(t *testing.T) {
@@ -91,6 +94,35 @@ func TestGetTypes(t *testing.T) {
 			d:           "",
 			expectedErr: ErrIndexOutOfBounds,
 		},
+		{
+			name:     "Negative index",
+			source:   []byte(`{"a": [{"status": "first"}, {"status": "latest"}]}`),
+			path:     "a.-1.status",
+			expected: "latest",
+			d:        "",
+		},
+		{
+			name:     "Negative index of the first element",
+			source:   []byte(`{"a": ["first", "latest"]}`),
+			path:     "a.-2",
+			expected: "first",
+			d:        "",
+		},
+		{
+			name:        "Negative index out of bounds",
+			source:      []byte(`{"a": ["nestedvalue"]}`),
+			path:        "a.-2",
+			expected:    "",
+			d:           "",
+			expectedErr: ErrIndexOutOfBounds,
+		},
+		{
+			name:     "Index of a slice longer than the source",
+			source:   []byte(`{"a": ["nestedvalue", "value"]}`),
+			path:     "a.1",
+			expected: "value",
+			d:        "",
+		},
 		{
 			name:        "Drill down beyond available depth",
 			source:      []byte(`{"a": "b"}`),
@@ -99,6 +131,13 @@ func TestGetTypes(t *testing.T) {
 			d:           "",
 			expectedErr: ErrEndOfNestedStructures,
 		},
+		{
+			name:     "Final key against a scalar returns the scalar",
+			source:   []byte(`{"a": "b"}`),
+			path:     "a.b",
+			expected: "b",
+			d:        "",
+		},
 		{
 			name:     "Get an int",
 			source:   []byte(`{"a": 1}`),
@@ -282,3 +321,1179 @@ func TestGetGenerics(t *testing.T) {
 		t.Errorf("Expected: hello but got: %s", result)
 	}
 }
+
+type testStringer struct {
+	value string
+}
+
+func (s testStringer) String() string {
+	return s.value
+}
+
+func TestGetNullable(t *testing.T) {
+	source := map[string]any{
+		"name":  "example",
+		"null":  nil,
+		"count": 42,
+		"list":  []any{nil},
+	}
+
+	tests := map[string]struct {
+		expected    string
+		present     bool
+		isNull      bool
+		expectedErr error
+	}{
+		"name":         {expected: "example", present: true},
+		"null":         {present: true, isNull: true},
+		"list.0":       {present: true, isNull: true},
+		"missing":      {},
+		"list.1":       {},
+		"count":        {present: true, expectedErr: ErrUnexpectedType},
+		"name.first.x": {expectedErr: ErrEndOfNestedStructures},
+	}
+
+	for path, tc := range tests {
+		t.Run(path, func(t *testing.T) {
+			result, present, isNull, err := GetNullable[string](source, path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected || present != tc.present || isNull != tc.isNull {
+				t.Errorf(
+					"Expected: (%#v, %t, %t) but got: (%#v, %t, %t)",
+					tc.expected, tc.present, tc.isNull, result, present, isNull,
+				)
+			}
+		})
+	}
+}
+
+func TestGetOK(t *testing.T) {
+	source := map[string]any{"name": "example", "count": 42.0, "null": nil}
+
+	if result, ok := GetOK[string](source, "name"); !ok || result != "example" {
+		t.Errorf("Expected: (example, true) but got: (%s, %t)", result, ok)
+	}
+
+	if result, ok := GetOK[float64](source, "count"); !ok || result != 42 {
+		t.Errorf("Expected: (42, true) but got: (%v, %t)", result, ok)
+	}
+
+	for _, path := range []string{"missing", "count", "name.first.x"} {
+		if result, ok := GetOK[string](source, path); ok || result != "" {
+			t.Errorf("Expected: (\"\", false) for %s but got: (%s, %t)", path, result, ok)
+		}
+	}
+
+	if result, ok := ReadOK[string](New(source), "name"); !ok || result != "example" {
+		t.Errorf("Expected: (example, true) but got: (%s, %t)", result, ok)
+	}
+}
+
+func TestFirstErr(t *testing.T) {
+	source := map[string]any{
+		"v1":   map[string]any{"email": 42.0},
+		"user": map[string]any{"contact": map[string]any{"email": "jo@example.com"}},
+	}
+
+	tests := map[string]struct {
+		paths       []string
+		expected    string
+		expectedErr error
+	}{
+		"first path":       {paths: []string{"user.contact.email", "user.email"}, expected: "jo@example.com"},
+		"fallback path":    {paths: []string{"user.email", "user.contact.email"}, expected: "jo@example.com"},
+		"skips wrong type": {paths: []string{"v1.email", "user.contact.email"}, expected: "jo@example.com"},
+		"none resolve":     {paths: []string{"user.email", "v1.email"}, expectedErr: ErrKeyNotFound},
+		"no paths":         {expectedErr: ErrInvalidPath},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := FirstErr[string](source, tc.paths...)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("Expected: %s but got: %s", tc.expected, result)
+			}
+		})
+	}
+
+	_, err := FirstErr[string](source, "user.email", "v1.email")
+	if !errors.Is(err, ErrUnexpectedType) || !strings.Contains(err.Error(), "path 'user.email'") {
+		t.Errorf("Expected the failure of every path to be joined, but got: %v", err)
+	}
+}
+
+func TestNullValues(t *testing.T) {
+	source := map[string]any{"null": nil, "name": "jo", "list": []any{nil}}
+
+	if _, err := StrErr(source, "null"); !errors.Is(err, ErrNilValue) || !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v wrapping %v, but got: %v", ErrNilValue, ErrUnexpectedType, err)
+	}
+
+	if _, err := IntErr(source, "list.0"); !errors.Is(err, ErrNilValue) {
+		t.Errorf("Expected error: %v, but got: %v", ErrNilValue, err)
+	}
+
+	if _, err := IntErr(source, "name"); errors.Is(err, ErrNilValue) || !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v only, but got: %v", ErrUnexpectedType, err)
+	}
+
+	tests := map[string]bool{"null": true, "list.0": true, "name": false, "missing": false, "name.x": false}
+	for path, expected := range tests {
+		if result := IsNull(source, path); result != expected {
+			t.Errorf("Expected IsNull(%s): %t but got: %t", path, expected, result)
+		}
+	}
+}
+
+func TestHasErr(t *testing.T) {
+	source := map[string]any{
+		"name": "example",
+		"null": nil,
+		"list": []any{map[string]any{"id": 1.0}},
+	}
+
+	tests := map[string]struct {
+		expected    bool
+		expectedErr error
+	}{
+		"name":         {expected: true},
+		"null":         {expected: true},
+		"list.0.id":    {expected: true},
+		"list.-1":      {expected: true},
+		"list.#":       {expected: true},
+		"missing":      {},
+		"list.1":       {},
+		"list.0.x":     {},
+		"name.first.x": {expectedErr: ErrEndOfNestedStructures},
+		"list.first":   {expectedErr: ErrNonIntegerSliceAccess},
+	}
+
+	for path, tc := range tests {
+		t.Run(path, func(t *testing.T) {
+			result, err := HasErr(source, path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("Expected: %t but got: %t", tc.expected, result)
+			}
+
+			if Has(source, path) != tc.expected {
+				t.Errorf("Expected Has to return: %t", tc.expected)
+			}
+		})
+	}
+}
+
+func TestLenErr(t *testing.T) {
+	source := map[string]any{
+		"list":   []any{1, 2, 3},
+		"map":    map[string]any{"a": 1, "b": 2},
+		"typed":  []string{"a"},
+		"array":  [2]int{1, 2},
+		"string": "héllo",
+		"empty":  "",
+		"number": float64(1),
+		"null":   nil,
+	}
+
+	tests := map[string]struct {
+		path        string
+		expected    int
+		expectedErr error
+	}{
+		"Slice":       {path: "list", expected: 3},
+		"Map":         {path: "map", expected: 2},
+		"Typed slice": {path: "typed", expected: 1},
+		"Array":       {path: "array", expected: 2},
+		"String":      {path: "string", expected: 5},
+		"Empty":       {path: "empty", expected: 0},
+		"Number":      {path: "number", expectedErr: ErrUnexpectedType},
+		"Null":        {path: "null", expectedErr: ErrNilValue},
+		"Missing key": {path: "missing", expectedErr: ErrKeyNotFound},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := LenErr(source, tc.path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("Expected: %d but got: %d", tc.expected, result)
+			}
+		})
+	}
+
+	if result := New(source).Len("list"); result != 3 {
+		t.Errorf("Expected: 3 but got: %d", result)
+	}
+}
+
+func TestGetInto(t *testing.T) {
+	source := map[string]any{"user": map[string]any{"name": "Dan", "age": 40}}
+
+	var user struct {
+		Name string
+		Age  int
+	}
+
+	if err := GetInto(source, "user.name", &user.Name); err != nil || user.Name != "Dan" {
+		t.Errorf("Expected: Dan but got: %s (%v)", user.Name, err)
+	}
+
+	if err := GetInto(source, "user.age", &user.Age); err != nil || user.Age != 40 {
+		t.Errorf("Expected: 40 but got: %d (%v)", user.Age, err)
+	}
+
+	user.Name = "unchanged"
+	if err := GetInto(source, "user.age", &user.Name); !errors.Is(err, ErrUnexpectedType) || user.Name != "unchanged" {
+		t.Errorf("Expected error: %v with dst untouched, but got: %v (%s)", ErrUnexpectedType, err, user.Name)
+	}
+
+	if err := GetInto(source, "user.email", &user.Name); !errors.Is(err, ErrKeyNotFound) || user.Name != "unchanged" {
+		t.Errorf("Expected error: %v with dst untouched, but got: %v (%s)", ErrKeyNotFound, err, user.Name)
+	}
+}
+
+func TestGetMany(t *testing.T) {
+	source := map[string]any{
+		"features": map[string]any{"height": 1.5, "width": 2.0, "label": "box"},
+	}
+
+	result, err := GetMany[float64](source, "features.height", "features.width")
+	if err != nil || !reflect.DeepEqual(result, []float64{1.5, 2.0}) {
+		t.Errorf("Expected: [1.5 2] but got: %v (%v)", result, err)
+	}
+
+	result, err = GetMany[float64](source, "features.height", "features.depth")
+	if !errors.Is(err, ErrKeyNotFound) || result != nil {
+		t.Errorf("Expected error: %v, but got: %v (%v)", ErrKeyNotFound, err, result)
+	}
+
+	result, err = GetManyPartial[float64](source, "features.label", "features.width", "features.depth")
+	if !errors.Is(err, ErrUnexpectedType) || !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected errors: %v and %v, but got: %v", ErrUnexpectedType, ErrKeyNotFound, err)
+	}
+
+	if !reflect.DeepEqual(result, []float64{0, 2.0, 0}) {
+		t.Errorf("Expected: [0 2 0] but got: %v", result)
+	}
+}
+
+func TestDefaultOK(t *testing.T) {
+	source := map[string]any{"name": "example", "count": 42, "ratios": []any{0.5}}
+
+	if result, usedDefault := StrDefaultOK(source, "name", "default"); result != "example" || usedDefault {
+		t.Errorf("Expected: (example, false) but got: (%s, %t)", result, usedDefault)
+	}
+
+	if result, usedDefault := StrDefaultOK(source, "missing", "default"); result != "default" || !usedDefault {
+		t.Errorf("Expected: (default, true) but got: (%s, %t)", result, usedDefault)
+	}
+
+	if result, usedDefault := IntDefaultOK(source, "count", 1); result != 42 || usedDefault {
+		t.Errorf("Expected: (42, false) but got: (%d, %t)", result, usedDefault)
+	}
+
+	if result, usedDefault := IntDefaultOK(source, "name", 1); result != 1 || !usedDefault {
+		t.Errorf("Expected: (1, true) but got: (%d, %t)", result, usedDefault)
+	}
+
+	if result, usedDefault := NumberSliceDefaultOK(source, "ratios", []float32{1}); result[0] != 0.5 || usedDefault {
+		t.Errorf("Expected: ([0.5], false) but got: (%v, %t)", result, usedDefault)
+	}
+
+	if result, usedDefault := MapDefaultOK[string](source, "name", nil); result != nil || !usedDefault {
+		t.Errorf("Expected: (map[], true) but got: (%v, %t)", result, usedDefault)
+	}
+}
+
+func TestDefaultFunc(t *testing.T) {
+	source := map[string]any{"name": "example", "count": 42}
+
+	calls := 0
+	d := func() string {
+		calls++
+		return "default"
+	}
+
+	if result := StrDefaultFunc(source, "name", d); result != "example" || calls != 0 {
+		t.Errorf("Expected: example with no default calls but got: %s with %d", result, calls)
+	}
+
+	if result := StrDefaultFunc(source, "missing", d); result != "default" || calls != 1 {
+		t.Errorf("Expected: default with 1 default call but got: %s with %d", result, calls)
+	}
+
+	if result := NumberDefaultFunc(source, "name", func() int64 { return 7 }); result != 7 {
+		t.Errorf("Expected: 7 but got: %d", result)
+	}
+
+	if result := GetDefaultFunc(source, "count", func() int { return 7 }); result != 42 {
+		t.Errorf("Expected: 42 but got: %d", result)
+	}
+}
+
+func TestBoolLenient(t *testing.T) {
+	enabled := true
+	source := map[string]any{
+		"bool":    false,
+		"true":    "TRUE",
+		"yes":     " yes ",
+		"no":      "No",
+		"on":      "on",
+		"off":     "off",
+		"one":     float64(1),
+		"zero":    int64(0),
+		"string1": "1",
+		"pointer": &enabled,
+		"two":     float64(2),
+		"half":    0.5,
+		"maybe":   "maybe",
+		"list":    []any{true},
+	}
+
+	tests := map[string]struct {
+		path        string
+		expected    bool
+		expectedErr error
+	}{
+		"Bool":         {path: "bool", expected: false},
+		"Upper true":   {path: "true", expected: true},
+		"Padded yes":   {path: "yes", expected: true},
+		"No":           {path: "no", expected: false},
+		"On":           {path: "on", expected: true},
+		"Off":          {path: "off", expected: false},
+		"One":          {path: "one", expected: true},
+		"Zero":         {path: "zero", expected: false},
+		"String one":   {path: "string1", expected: true},
+		"Pointer":      {path: "pointer", expected: true},
+		"Two":          {path: "two", expectedErr: ErrUnableToConvert},
+		"Fraction":     {path: "half", expectedErr: ErrUnableToConvert},
+		"Unrecognised": {path: "maybe", expectedErr: ErrUnableToConvert},
+		"List":         {path: "list", expectedErr: ErrUnexpectedType},
+		"Missing key":  {path: "missing", expectedErr: ErrKeyNotFound},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := BoolLenientErr(source, tc.path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+
+	if _, err := BoolErr(source, "yes"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	if result := New(source).BoolLenientDefault("maybe", true); !result {
+		t.Errorf("Expected: true but got: %v", result)
+	}
+}
+
+func TestBytesBase64(t *testing.T) {
+	source := map[string]any{
+		"std":     "aGk/Pz4+",
+		"url":     "aGk_Pz4-",
+		"padded":  "aGk=",
+		"raw":     "aGk",
+		"wrapped": "aGk/\r\nPz4+",
+		"bytes":   []byte("hi"),
+		"invalid": "not base64!",
+		"number":  float64(1),
+	}
+
+	tests := map[string]struct {
+		path        string
+		expected    string
+		expectedErr error
+	}{
+		"Standard alphabet": {path: "std", expected: "hi??>>"},
+		"URL alphabet":      {path: "url", expected: "hi??>>"},
+		"Padded":            {path: "padded", expected: "hi"},
+		"Unpadded":          {path: "raw", expected: "hi"},
+		"Line breaks":       {path: "wrapped", expected: "hi??>>"},
+		"Bytes":             {path: "bytes", expected: "hi"},
+		"Invalid":           {path: "invalid", expectedErr: ErrUnableToConvert},
+		"Number":            {path: "number", expectedErr: ErrUnexpectedType},
+		"Missing key":       {path: "missing", expectedErr: ErrKeyNotFound},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := BytesBase64Err(source, tc.path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if string(result) != tc.expected {
+				t.Errorf("Expected: %q but got: %q", tc.expected, result)
+			}
+		})
+	}
+
+	if result := New(source).BytesBase64("padded"); string(result) != "hi" {
+		t.Errorf("Expected: hi but got: %s", result)
+	}
+}
+
+func TestBytesStrict(t *testing.T) {
+	source := map[string]any{"raw": []byte("raw"), "str": "str"}
+
+	if result, err := BytesStrictErr(source, "raw"); err != nil || string(result) != "raw" {
+		t.Errorf("Expected: raw but got: %s (%v)", result, err)
+	}
+
+	if _, err := BytesStrictErr(source, "str"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	if result := BytesStrictDefault(source, "str", []byte("default")); string(result) != "default" {
+		t.Errorf("Expected: default but got: %s", result)
+	}
+
+	if result, err := BytesErr(source, "str"); err != nil || string(result) != "str" {
+		t.Errorf("Expected: str but got: %s (%v)", result, err)
+	}
+}
+
+func TestStrLenient(t *testing.T) {
+	source := map[string]any{
+		"str":      "plain",
+		"stringer": testStringer{value: "stringer"},
+		"err":      errors.New("failure"),
+		"num":      42,
+	}
+
+	tests := map[string]struct {
+		expected    string
+		expectedErr error
+	}{
+		"str":      {expected: "plain"},
+		"stringer": {expected: "stringer"},
+		"err":      {expected: "failure"},
+		"num":      {expected: "", expectedErr: ErrUnableToConvert},
+		"missing":  {expected: "", expectedErr: ErrKeyNotFound},
+	}
+
+	for path, tc := range tests {
+		t.Run(path, func(t *testing.T) {
+			result, err := StrLenientErr(source, path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("Expected: %#v but got: %#v", tc.expected, result)
+			}
+
+			if altResult := StrLenient(source, path); altResult != result {
+				t.Errorf("Variations should return the same value %#v != %#v", result, altResult)
+			}
+
+			if tc.expectedErr != nil && StrLenientDefault(source, path, "default") != "default" {
+				t.Error("Default should be used when lookup fails")
+			}
+		})
+	}
+
+	if _, err := StrErr(source, "stringer"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("StrErr should remain strict, but got: %v", err)
+	}
+}
+
+func TestStrCoerce(t *testing.T) {
+	port := 8080
+	source := map[string]any{
+		"string":   "text",
+		"int":      float64(42),
+		"float":    3.5,
+		"large":    1e21,
+		"small":    float32(0.1),
+		"negative": int64(-7),
+		"uint":     uint8(255),
+		"bool":     true,
+		"number":   json.Number("12345678901234567890"),
+		"pointer":  &port,
+		"timeout":  5 * time.Second,
+		"list":     []any{1},
+		"null":     nil,
+	}
+
+	tests := map[string]struct {
+		path        string
+		expected    string
+		expectedErr error
+	}{
+		"String":         {path: "string", expected: "text"},
+		"Integral float": {path: "int", expected: "42"},
+		"Float":          {path: "float", expected: "3.5"},
+		"Large float":    {path: "large", expected: "1e+21"},
+		"Float32":        {path: "small", expected: "0.1"},
+		"Negative int":   {path: "negative", expected: "-7"},
+		"Uint":           {path: "uint", expected: "255"},
+		"Bool":           {path: "bool", expected: "true"},
+		"JSON number":    {path: "number", expected: "12345678901234567890"},
+		"Pointer":        {path: "pointer", expected: "8080"},
+		"Stringer":       {path: "timeout", expected: "5s"},
+		"List":           {path: "list", expectedErr: ErrUnexpectedType},
+		"Null":           {path: "null", expectedErr: ErrNilValue},
+		"Missing key":    {path: "missing", expectedErr: ErrKeyNotFound},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := StrCoerceErr(source, tc.path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("Expected: %q but got: %q", tc.expected, result)
+			}
+		})
+	}
+
+	if _, err := StrErr(source, "int"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	if result := New(source).StrCoerceDefault("missing", "none"); result != "none" {
+		t.Errorf("Expected: none but got: %v", result)
+	}
+}
+
+func TestStrSliceFlexible(t *testing.T) {
+	source := map[string]any{
+		"single": "one",
+		"list":   []any{"one", "two"},
+		"typed":  []string{"one"},
+		"mixed":  []any{"one", 2},
+		"num":    42,
+	}
+
+	tests := map[string]struct {
+		expected    []string
+		expectedErr error
+	}{
+		"single":  {expected: []string{"one"}},
+		"list":    {expected: []string{"one", "two"}},
+		"typed":   {expected: []string{"one"}},
+		"mixed":   {expectedErr: ErrUnableToConvert},
+		"num":     {expectedErr: ErrUnexpectedType},
+		"missing": {expectedErr: ErrKeyNotFound},
+	}
+
+	for path, tc := range tests {
+		t.Run(path, func(t *testing.T) {
+			result, err := StrSliceFlexible(source, path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected: %#v but got: %#v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestGetTextUnmarshaler(t *testing.T) {
+	source := map[string]any{
+		"time":    "2024-02-03T04:05:06Z",
+		"addr":    "192.168.0.1",
+		"badTime": "yesterday",
+		"num":     42,
+	}
+
+	expectedTime := time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC)
+
+	result, err := GetErr[time.Time](source, "time")
+	if err != nil {
+		t.Errorf("GetErr should not return an error for a valid query, got: %v", err)
+	}
+
+	if !result.Equal(expectedTime) {
+		t.Errorf("Expected: %v but got: %v", expectedTime, result)
+	}
+
+	ptrResult, err := GetErr[*time.Time](source, "time")
+	if err != nil {
+		t.Errorf("GetErr should not return an error for a valid query, got: %v", err)
+	}
+
+	if ptrResult == nil || !ptrResult.Equal(expectedTime) {
+		t.Errorf("Expected: %v but got: %v", expectedTime, ptrResult)
+	}
+
+	addr, err := GetErr[netip.Addr](source, "addr")
+	if err != nil || addr != netip.MustParseAddr("192.168.0.1") {
+		t.Errorf("Expected: 192.168.0.1 but got: %v (%v)", addr, err)
+	}
+
+	if _, err := GetErr[time.Time](source, "badTime"); !errors.Is(err, ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, err)
+	}
+
+	if _, err := GetErr[netip.Addr](source, "num"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+}
+
+type testPoint struct {
+	X, Y int
+}
+
+func (p *testPoint) UnmarshalJSON(data []byte) error {
+	var coords []int
+	if err := json.Unmarshal(data, &coords); err != nil {
+		return err
+	}
+
+	if len(coords) != 2 {
+		return errors.New("point requires exactly two coordinates")
+	}
+
+	p.X, p.Y = coords[0], coords[1]
+
+	return nil
+}
+
+func TestGetJSONUnmarshaler(t *testing.T) {
+	source := map[string]any{}
+	if err := json.Unmarshal([]byte(`{"point": [1, 2], "bad": [1, 2, 3], "raw": {"a": [true]}}`), &source); err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	result, err := GetErr[testPoint](source, "point")
+	if err != nil || result != (testPoint{X: 1, Y: 2}) {
+		t.Errorf("Expected: {1 2} but got: %v (%v)", result, err)
+	}
+
+	ptrResult, err := GetErr[*testPoint](source, "point")
+	if err != nil || ptrResult == nil || *ptrResult != (testPoint{X: 1, Y: 2}) {
+		t.Errorf("Expected: &{1 2} but got: %v (%v)", ptrResult, err)
+	}
+
+	if _, err := GetErr[testPoint](source, "bad"); !errors.Is(err, ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, err)
+	}
+
+	raw, err := GetErr[json.RawMessage](source, "raw")
+	if err != nil || string(raw) != `{"a":[true]}` {
+		t.Errorf("Expected: {\"a\":[true]} but got: %s (%v)", raw, err)
+	}
+}
+
+type testColor string
+
+func TestGetNativeTypes(t *testing.T) {
+	now := time.Now()
+	port := 8080
+	source := map[string]any{
+		"time":     now,
+		"timePtr":  &now,
+		"timeout":  5 * time.Second,
+		"port":     &port,
+		"color":    testColor("red"),
+		"raw":      json.RawMessage(`{}`),
+		"duration": "1s",
+	}
+
+	if result, err := GetErr[time.Time](source, "time"); err != nil || !result.Equal(now) {
+		t.Errorf("Expected: %v but got: %v (%v)", now, result, err)
+	}
+
+	if result, err := GetErr[time.Time](source, "timePtr"); err != nil || !result.Equal(now) {
+		t.Errorf("Expected: %v but got: %v (%v)", now, result, err)
+	}
+
+	if result, err := NumberErr[int64](source, "timeout"); err != nil || result != int64(5*time.Second) {
+		t.Errorf("Expected: %d but got: %d (%v)", 5*time.Second, result, err)
+	}
+
+	if result, err := GetErr[time.Duration](source, "timeout"); err != nil || result != 5*time.Second {
+		t.Errorf("Expected: %v but got: %v (%v)", 5*time.Second, result, err)
+	}
+
+	if result, err := IntErr(source, "port"); err != nil || result != 8080 {
+		t.Errorf("Expected: 8080 but got: %d (%v)", result, err)
+	}
+
+	if result, err := StrErr(source, "color"); err != nil || result != "red" {
+		t.Errorf("Expected: red but got: %s (%v)", result, err)
+	}
+
+	if result, err := StrLenientErr(source, "color"); err != nil || result != "red" {
+		t.Errorf("Expected: red but got: %s (%v)", result, err)
+	}
+
+	if result, err := BytesErr(source, "raw"); err != nil || string(result) != "{}" {
+		t.Errorf("Expected: {} but got: %s (%v)", result, err)
+	}
+
+	if _, err := IntErr(source, "time"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	if _, err := GetErr[int](source, "duration"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+}
+
+func TestGetJSONNumbers(t *testing.T) {
+	source := map[string]any{
+		"id":       json.Number("9007199254740993"),
+		"price":    json.Number("3.5"),
+		"negative": json.Number("-42"),
+		"max":      json.Number("18446744073709551615"),
+		"invalid":  json.Number("lots"),
+		"list":     []any{json.Number("1"), json.Number("2")},
+	}
+
+	if result, err := NumberErr[int64](source, "id"); err != nil || result != 9007199254740993 {
+		t.Errorf("Expected: 9007199254740993 but got: %d (%v)", result, err)
+	}
+
+	if result, err := Float64Err(source, "price"); err != nil || result != 3.5 {
+		t.Errorf("Expected: 3.5 but got: %v (%v)", result, err)
+	}
+
+	if result, err := IntErr(source, "negative"); err != nil || result != -42 {
+		t.Errorf("Expected: -42 but got: %d (%v)", result, err)
+	}
+
+	if result, err := NumberErr[uint64](source, "max"); err != nil || result != 18446744073709551615 {
+		t.Errorf("Expected: 18446744073709551615 but got: %d (%v)", result, err)
+	}
+
+	if result, err := NumberSliceErr[int](source, "list"); err != nil || !reflect.DeepEqual(result, []int{1, 2}) {
+		t.Errorf("Expected: [1 2] but got: %v (%v)", result, err)
+	}
+
+	if _, err := IntErr(source, "price"); !errors.Is(err, ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, err)
+	}
+
+	if _, err := NumberErr[int8](source, "negative"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if _, err := IntErr(source, "invalid"); !errors.Is(err, ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, err)
+	}
+}
+
+func TestNumberParsing(t *testing.T) {
+	source := map[string]any{
+		"age":    "42",
+		"price":  " 3.14 ",
+		"big":    "9007199254740993",
+		"list":   []any{"1", float64(2)},
+		"word":   "lots",
+		"number": float64(7),
+	}
+
+	r := New(source, WithNumberParsing())
+
+	if result, err := r.IntErr("age"); err != nil || result != 42 {
+		t.Errorf("Expected: 42 but got: %d (%v)", result, err)
+	}
+
+	if result, err := r.Float64Err("price"); err != nil || result != 3.14 {
+		t.Errorf("Expected: 3.14 but got: %v (%v)", result, err)
+	}
+
+	if result, err := ReadNumberErr[int64](r, "big"); err != nil || result != 9007199254740993 {
+		t.Errorf("Expected: 9007199254740993 but got: %d (%v)", result, err)
+	}
+
+	if result, err := ReadNumberSliceErr[int](r, "list"); err != nil || !reflect.DeepEqual(result, []int{1, 2}) {
+		t.Errorf("Expected: [1 2] but got: %v (%v)", result, err)
+	}
+
+	if result, err := r.IntErr("number"); err != nil || result != 7 {
+		t.Errorf("Expected: 7 but got: %d (%v)", result, err)
+	}
+
+	var age uint8
+	if err := r.Scan("age", &age); err != nil || age != 42 {
+		t.Errorf("Expected: 42 but got: %d (%v)", age, err)
+	}
+
+	if _, err := r.IntErr("price"); !errors.Is(err, ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, err)
+	}
+
+	if _, err := r.IntErr("word"); !errors.Is(err, ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, err)
+	}
+
+	if _, err := IntErr(source, "age"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+}
+
+func TestSliceTyped(t *testing.T) {
+	type tags []string
+
+	roles := []string{"admin", "user"}
+	source := map[string]any{
+		"roles":  roles,
+		"tags":   tags{"a", "b"},
+		"ptr":    &roles,
+		"ports":  []int{80, 443},
+		"tables": []map[string]any{{"a": 1}},
+	}
+
+	result, err := SliceErr[string](source, "roles")
+	if err != nil || !reflect.DeepEqual(result, roles) {
+		t.Errorf("Expected: %v but got: %v (%v)", roles, result, err)
+	}
+
+	if &result[0] != &roles[0] {
+		t.Errorf("Expected the slice to be returned without copying")
+	}
+
+	if result, err := SliceErr[string](source, "tags"); err != nil || !reflect.DeepEqual(result, []string{"a", "b"}) {
+		t.Errorf("Expected: [a b] but got: %v (%v)", result, err)
+	}
+
+	if result, err := SliceErr[string](source, "ptr"); err != nil || !reflect.DeepEqual(result, roles) {
+		t.Errorf("Expected: %v but got: %v (%v)", roles, result, err)
+	}
+
+	if result, err := SliceErr[any](source, "ports"); err != nil || !reflect.DeepEqual(result, []any{80, 443}) {
+		t.Errorf("Expected: [80 443] but got: %v (%v)", result, err)
+	}
+
+	if result, err := SliceErr[map[string]any](source, "tables"); err != nil || len(result) != 1 {
+		t.Errorf("Expected: [map[a:1]] but got: %v (%v)", result, err)
+	}
+
+	if _, err := SliceErr[string](source, "ports"); !errors.Is(err, ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, err)
+	}
+}
+
+func TestSliceInto(t *testing.T) {
+	source := map[string]any{}
+	if err := json.Unmarshal([]byte(`{"a": [1, 2, 3], "b": [4], "c": [true, "x"]}`), &source); err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	buf := make([]float64, 0, 8)
+	buf, err := SliceInto(source, "a", buf)
+	if err != nil || !reflect.DeepEqual(buf, []float64{1, 2, 3}) {
+		t.Errorf("Expected: [1 2 3] but got: %v (%v)", buf, err)
+	}
+
+	first := &buf[:1][0]
+	buf, err = SliceInto(source, "b", buf)
+	if err != nil || !reflect.DeepEqual(buf, []float64{4}) {
+		t.Errorf("Expected: [4] but got: %v (%v)", buf, err)
+	}
+
+	if &buf[0] != first {
+		t.Error("SliceInto should reuse the backing array of dst")
+	}
+
+	buf, err = SliceInto(source, "c", buf)
+	if !errors.Is(err, ErrUnableToConvert) || len(buf) != 0 {
+		t.Errorf("Expected an empty slice and error: %v, but got: %v (%v)", ErrUnableToConvert, buf, err)
+	}
+
+	if allocs := testing.AllocsPerRun(100, func() { buf, _ = SliceInto(source, "a", buf) }); allocs > 0 {
+		t.Errorf("SliceInto should not allocate when dst has capacity, got %v allocations", allocs)
+	}
+}
+
+func TestMapTyped(t *testing.T) {
+	type labels map[string]string
+
+	counts := map[string]int{"a": 1, "b": 2}
+	source := map[string]any{
+		"counts": counts,
+		"labels": labels{"env": "prod"},
+		"nested": map[string]map[string]any{"x": {"y": 1}},
+		"ids":    map[int]string{1: "a"},
+	}
+
+	result, err := MapErr[int](source, "counts")
+	if err != nil || !reflect.DeepEqual(result, counts) {
+		t.Errorf("Expected: %v but got: %v (%v)", counts, result, err)
+	}
+
+	if result, err := MapErr[string](source, "labels"); err != nil || result["env"] != "prod" {
+		t.Errorf("Expected: map[env:prod] but got: %v (%v)", result, err)
+	}
+
+	if result, err := MapErr[any](source, "counts"); err != nil || !reflect.DeepEqual(result, map[string]any{"a": 1, "b": 2}) {
+		t.Errorf("Expected: map[a:1 b:2] but got: %v (%v)", result, err)
+	}
+
+	if result, err := GetErr[map[string]any](source, "nested"); err != nil || len(result) != 1 {
+		t.Errorf("Expected: map[x:map[y:1]] but got: %v (%v)", result, err)
+	}
+
+	if _, err := MapErr[string](source, "counts"); !errors.Is(err, ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, err)
+	}
+
+	if _, err := MapErr[string](source, "ids"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+}
+
+func TestMapInto(t *testing.T) {
+	source := map[string]any{}
+	if err := json.Unmarshal([]byte(`{"a": {"x": "1", "y": "2"}, "b": {"z": "3"}, "c": {"x": 1}}`), &source); err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	dst := map[string]string{}
+	if err := MapInto(source, "a", dst); err != nil || !reflect.DeepEqual(dst, map[string]string{"x": "1", "y": "2"}) {
+		t.Errorf("Expected: map[x:1 y:2] but got: %v (%v)", dst, err)
+	}
+
+	if err := MapInto(source, "b", dst); err != nil || !reflect.DeepEqual(dst, map[string]string{"z": "3"}) {
+		t.Errorf("Expected: map[z:3] but got: %v (%v)", dst, err)
+	}
+
+	if err := MapInto(source, "c", dst); !errors.Is(err, ErrUnableToConvert) || len(dst) != 0 {
+		t.Errorf("Expected an empty map and error: %v, but got: %v (%v)", ErrUnableToConvert, dst, err)
+	}
+}
+
+type testKey string
+
+func TestGetNonStringKeys(t *testing.T) {
+	source := map[string]any{
+		"ints":   map[int]any{1: "one", -2: map[string]any{"a": "minus two"}},
+		"uints":  map[uint8]any{255: "max"},
+		"named":  map[testKey]any{"k": "named"},
+		"any":    map[any]any{"name": "str", 3: "three"},
+		"int64s": map[int64]string{7: "seven"},
+		"msgpack": []any{
+			map[any]any{int64(1): map[any]any{"name": "int64"}},
+			map[any]any{uint64(1): map[any]any{"name": "uint64"}},
+			map[any]any{int8(-1): "int8", uint32(70000): "uint32"},
+		},
+	}
+
+	tests := []struct {
+		path        string
+		expected    string
+		expectedErr error
+	}{
+		{path: "ints.1", expected: "one"},
+		{path: "ints.-2.a", expected: "minus two"},
+		{path: "ints.3", expectedErr: ErrKeyNotFound},
+		{path: "ints.one", expectedErr: ErrKeyNotFound},
+		{path: "uints.255", expected: "max"},
+		{path: "uints.256", expectedErr: ErrKeyNotFound},
+		{path: "named.k", expected: "named"},
+		{path: "any.name", expected: "str"},
+		{path: "any.3", expected: "three"},
+		{path: "int64s.7", expected: "seven"},
+		{path: "int64s.7.x.y", expectedErr: ErrEndOfNestedStructures},
+		{path: "msgpack.0.1.name", expected: "int64"},
+		{path: "msgpack.1.1.name", expected: "uint64"},
+		{path: "msgpack.2.-1", expected: "int8"},
+		{path: "msgpack.2.70000", expected: "uint32"},
+		{path: "msgpack.0.2", expectedErr: ErrKeyNotFound},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			result, err := StrErr(source, tc.path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("Expected: %#v but got: %#v", tc.expected, result)
+			}
+		})
+	}
+}
+
+type testLatLng struct {
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func TestGetFirestoreShapes(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	source := map[string]any{
+		"name":     "Leeds",
+		"visits":   int64(1 << 60),
+		"rating":   4.5,
+		"created":  created,
+		"location": &testLatLng{Latitude: 53.8, Longitude: -1.55},
+		"scores":   []any{int64(1), 2.5, int64(3)},
+		"tags":     []any{"north", "city"},
+		"meta":     map[string]any{"floors": int64(12)},
+	}
+
+	if result, err := NumberErr[int64](source, "visits"); err != nil || result != 1<<60 {
+		t.Errorf("Expected: %d but got: %d (%v)", int64(1<<60), result, err)
+	}
+
+	if result, err := IntErr(source, "meta.floors"); err != nil || result != 12 {
+		t.Errorf("Expected: 12 but got: %d (%v)", result, err)
+	}
+
+	if result, err := Float64Err(source, "meta.floors"); err != nil || result != 12 {
+		t.Errorf("Expected: 12 but got: %v (%v)", result, err)
+	}
+
+	if result, err := GetErr[time.Time](source, "created"); err != nil || !result.Equal(created) {
+		t.Errorf("Expected: %v but got: %v (%v)", created, result, err)
+	}
+
+	if result, err := Float64Err(source, "location.latitude"); err != nil || result != 53.8 {
+		t.Errorf("Expected: 53.8 but got: %v (%v)", result, err)
+	}
+
+	if result, err := NumberSliceErr[float64](source, "scores"); err != nil || !reflect.DeepEqual(result, []float64{1, 2.5, 3}) {
+		t.Errorf("Expected: [1 2.5 3] but got: %v (%v)", result, err)
+	}
+
+	if _, err := NumberSliceErr[int](source, "scores"); !errors.Is(err, ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, err)
+	}
+
+	if _, err := NumberSliceErr[int](source, "tags"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	if result := NumberSliceDefault(source, "missing", []int{7}); !reflect.DeepEqual(result, []int{7}) {
+		t.Errorf("Expected: [7] but got: %v", result)
+	}
+
+	if result := NumberSlice[int64](source, "scores"); result != nil {
+		t.Errorf("Expected: nil but got: %v", result)
+	}
+}
+
+func TestGetTOMLShapes(t *testing.T) {
+	released := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	source := map[string]any{
+		"server": map[string]any{"port": int64(8080), "ratio": 0.5, "released": released},
+		"products": []map[string]any{
+			{"name": "Hammer", "sku": int64(738594937)},
+			{"name": "Nail", "sku": int64(284758393)},
+		},
+		"ports": []int64{80, 443},
+	}
+
+	if result, err := IntErr(source, "server.port"); err != nil || result != 8080 {
+		t.Errorf("Expected: 8080 but got: %d (%v)", result, err)
+	}
+
+	if result, err := Float64Err(source, "server.port"); err != nil || result != 8080 {
+		t.Errorf("Expected: 8080 but got: %v (%v)", result, err)
+	}
+
+	if result, err := GetErr[time.Time](source, "server.released"); err != nil || !result.Equal(released) {
+		t.Errorf("Expected: %v but got: %v (%v)", released, result, err)
+	}
+
+	if result, err := StrErr(source, "products.1.name"); err != nil || result != "Nail" {
+		t.Errorf("Expected: Nail but got: %s (%v)", result, err)
+	}
+
+	if result, err := SliceErr[map[string]any](source, "products"); err != nil || len(result) != 2 {
+		t.Errorf("Expected: 2 products but got: %v (%v)", result, err)
+	}
+
+	if result, err := Column[string](source, "products", "name"); err != nil || !reflect.DeepEqual(result, []string{"Hammer", "Nail"}) {
+		t.Errorf("Expected: [Hammer Nail] but got: %v (%v)", result, err)
+	}
+
+	if result, err := NumberSliceErr[int](source, "ports"); err != nil || !reflect.DeepEqual(result, []int{80, 443}) {
+		t.Errorf("Expected: [80 443] but got: %v (%v)", result, err)
+	}
+
+	if _, err := GetErr[[]any](map[string]any{"raw": []byte("raw")}, "raw"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+}
+
+func TestGetExtendedJSON(t *testing.T) {
+	source := map[string]any{}
+	err := json.Unmarshal([]byte(`{
+		"_id": {"$oid": "5d505646cf6d4fe581014ab2"},
+		"count": {"$numberLong": "9007199254740993"},
+		"small": {"$numberInt": "42"},
+		"ratio": {"$numberDouble": "0.25"},
+		"price": {"$numberDecimal": "9.99"},
+		"created": {"$date": {"$numberLong": "1565546054692"}},
+		"updated": {"$date": "2019-08-11T17:54:14.692Z"},
+		"legacy": {"$date": 1565546054692},
+		"data": {"$binary": {"base64": "aGVsbG8=", "subType": "00"}},
+		"oldData": {"$binary": "aGVsbG8=", "$type": "00"},
+		"bad": {"$numberLong": "abc"}
+	}`), &source)
+	if err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	if result, err := StrErr(source, "_id"); err != nil || result != "5d505646cf6d4fe581014ab2" {
+		t.Errorf("Expected: 5d505646cf6d4fe581014ab2 but got: %s (%v)", result, err)
+	}
+
+	if result, err := NumberErr[int64](source, "count"); err != nil || result != 9007199254740993 {
+		t.Errorf("Expected: 9007199254740993 but got: %d (%v)", result, err)
+	}
+
+	if result, err := IntErr(source, "small"); err != nil || result != 42 {
+		t.Errorf("Expected: 42 but got: %d (%v)", result, err)
+	}
+
+	if result, err := Float64Err(source, "ratio"); err != nil || result != 0.25 {
+		t.Errorf("Expected: 0.25 but got: %v (%v)", result, err)
+	}
+
+	if result, err := StrErr(source, "price"); err != nil || result != "9.99" {
+		t.Errorf("Expected: 9.99 but got: %s (%v)", result, err)
+	}
+
+	expectedTime := time.UnixMilli(1565546054692).UTC()
+	for _, path := range []string{"created", "updated", "legacy"} {
+		if result, err := GetErr[time.Time](source, path); err != nil || !result.Equal(expectedTime) {
+			t.Errorf("%s: expected: %v but got: %v (%v)", path, expectedTime, result, err)
+		}
+	}
+
+	for _, path := range []string{"data", "oldData"} {
+		if result, err := BytesErr(source, path); err != nil || string(result) != "hello" {
+			t.Errorf("%s: expected: hello but got: %s (%v)", path, result, err)
+		}
+	}
+
+	if result, err := GetErr[map[string]any](source, "_id"); err != nil || result["$oid"] == nil {
+		t.Errorf("Wrappers should be returned as is when they match the requested type, got: %v (%v)", result, err)
+	}
+
+	if _, err := IntErr(source, "bad"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+}
@@ -0,0 +1,130 @@
+package mapreader
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Modifier transforms the value found at a lookup path, given the (possibly empty) argument following its name
+type Modifier func(value any, arg string) (any, error)
+
+var (
+	modifiersMu sync.RWMutex
+	modifiers   = map[string]Modifier{
+		"keys":    keysModifier,
+		"lower":   stringModifier(strings.ToLower),
+		"reverse": reverseModifier,
+		"round":   roundModifier,
+		"trim":    stringModifier(strings.TrimSpace),
+		"upper":   stringModifier(strings.ToUpper),
+	}
+)
+
+// RegisterModifier registers a modifier, which can then be applied to values by appending "|name" to a lookup path
+//
+// Modifiers are applied in order, each to the result of the last, so "name|trim|lower" trims then lowercases a string.
+// An argument can follow the name of a modifier after a ':', e.g. "price|round:2".
+// The built in modifiers are:
+//   - keys: the sorted keys of a map, as []any
+//   - lower, upper and trim: the lowercased, uppercased or whitespace trimmed form of a string
+//   - reverse: a reversed copy of a slice
+//   - round: a number rounded to the number of decimal places given by the argument (default 0)
+//
+// Registering a modifier with the name of an existing one replaces it.
+func RegisterModifier(name string, fn Modifier) {
+	modifiersMu.Lock()
+	defer modifiersMu.Unlock()
+
+	modifiers[name] = fn
+}
+
+// applyModifiers applies each of the modifiers, in order, to the value
+func applyModifiers(value any, names []string, opts *Options) (any, error) {
+	for _, modifier := range names {
+		name, arg, _ := strings.Cut(modifier, ":")
+
+		modifiersMu.RLock()
+		fn, ok := modifiers[name]
+		modifiersMu.RUnlock()
+
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown modifier '%s'", ErrInvalidPath, name)
+		}
+
+		modified, err := fn(value, arg)
+		for unwrapped, ok := unwrap(value, opts); err != nil && ok; unwrapped, ok = unwrap(unwrapped, opts) {
+			modified, err = fn(unwrapped, arg)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("modifier '%s': %w", name, err)
+		}
+		value = modified
+	}
+
+	return value, nil
+}
+
+// stringModifier returns a modifier applying the given function to strings
+func stringModifier(fn func(string) string) Modifier {
+	return func(value any, _ string) (any, error) {
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected a string but got '%T'", ErrUnexpectedType, value)
+		}
+
+		return fn(s), nil
+	}
+}
+
+// keysModifier returns the sorted keys of a map
+func keysModifier(value any, _ string) (any, error) {
+	if reflect.ValueOf(value).Kind() != reflect.Map {
+		return nil, fmt.Errorf("%w: expected a map but got '%T'", ErrUnexpectedType, value)
+	}
+
+	keys := childKeys(value)
+	result := make([]any, len(keys))
+	for i, k := range keys {
+		result[i] = k
+	}
+
+	return result, nil
+}
+
+// reverseModifier returns a reversed copy of a slice
+func reverseModifier(value any, _ string) (any, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("%w: expected a slice but got '%T'", ErrUnexpectedType, value)
+	}
+
+	reversed := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	for i := 0; i < v.Len(); i++ {
+		reversed.Index(v.Len() - 1 - i).Set(v.Index(i))
+	}
+
+	return reversed.Interface(), nil
+}
+
+// roundModifier rounds a number to the number of decimal places given by the argument
+func roundModifier(value any, arg string) (any, error) {
+	f, err := asNumberType[float64](value)
+	if err != nil {
+		return nil, err
+	}
+
+	places := 0
+	if arg != "" {
+		if places, err = strconv.Atoi(arg); err != nil {
+			return nil, fmt.Errorf("%w: round requires an integer number of places but got '%s'", ErrInvalidPath, arg)
+		}
+	}
+
+	scale := math.Pow10(places)
+	return math.Round(f*scale) / scale, nil
+}
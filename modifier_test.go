@@ -0,0 +1,77 @@
+package mapreader
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestModifiers(t *testing.T) {
+	source := map[string]any{
+		"name":   "  Jo Bloggs ",
+		"items":  []any{1.0, 2.0, 3.0},
+		"ports":  []int{80, 443},
+		"price":  12.3456,
+		"user":   map[string]any{"b": 1.0, "a": 2.0},
+		"pipe|x": "literal",
+		"users":  []any{map[string]any{"name": "Jo"}, map[string]any{"name": "Dan"}},
+	}
+
+	tests := map[string]struct {
+		expected    any
+		expectedErr error
+	}{
+		"name|trim":                  {expected: "Jo Bloggs"},
+		"name|trim|lower":            {expected: "jo bloggs"},
+		"items|reverse":              {expected: []any{3.0, 2.0, 1.0}},
+		"ports|reverse":              {expected: []int{443, 80}},
+		"price|round":                {expected: 12.0},
+		"price|round:2":              {expected: 12.35},
+		"user|keys":                  {expected: []any{"a", "b"}},
+		`pipe\|x`:                    {expected: "literal"},
+		"users.[name=Jo].name|upper": {expected: "JO"},
+		"items|unknown":              {expectedErr: ErrInvalidPath},
+		"items|lower":                {expectedErr: ErrUnexpectedType},
+		"price|round:x":              {expectedErr: ErrInvalidPath},
+		"missing|lower":              {expectedErr: ErrKeyNotFound},
+	}
+
+	for path, tc := range tests {
+		t.Run(path, func(t *testing.T) {
+			result, err := GetErr[any](source, path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+
+	if result := Slice[float64](source, "items|reverse"); !reflect.DeepEqual(result, []float64{3, 2, 1}) {
+		t.Errorf("Expected: [3 2 1] but got: %v", result)
+	}
+
+	if result := GetAll[string](source, "users.*.name|lower"); !reflect.DeepEqual(result, []string{"jo", "dan"}) {
+		t.Errorf("Expected: [jo dan] but got: %v", result)
+	}
+}
+
+func TestRegisterModifier(t *testing.T) {
+	RegisterModifier("repeat", func(value any, arg string) (any, error) {
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected a string", ErrUnexpectedType)
+		}
+
+		return strings.Repeat(s, len(arg)), nil
+	})
+
+	source := map[string]any{"a": "ab"}
+	if result, err := StrErr(source, "a|repeat:xxx|upper"); err != nil || result != "ABABAB" {
+		t.Errorf("Expected: ABABAB but got: %s (%v)", result, err)
+	}
+}
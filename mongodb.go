@@ -0,0 +1,95 @@
+package mapreader
+
+import (
+	"encoding/base64"
+	"strconv"
+	"time"
+)
+
+// extendedJSONValue unwraps a MongoDB Extended JSON wrapper into its plain Go equivalent
+//
+// $numberInt and $numberLong become int64, $numberDouble becomes float64, $date becomes time.Time,
+// $binary becomes []byte, whilst $oid, $symbol and $numberDecimal become their string representation.
+// Both the canonical and relaxed forms of each wrapper are supported.
+func extendedJSONValue(v any) (any, bool) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+
+	if len(m) == 2 {
+		// Legacy binary format: {"$binary": "<base64>", "$type": "<subtype>"}
+		if _, ok := m["$type"]; ok {
+			return extendedJSONBinary(m["$binary"])
+		}
+	}
+
+	if len(m) != 1 {
+		return nil, false
+	}
+
+	for key, value := range m {
+		switch key {
+		case "$oid", "$symbol", "$numberDecimal":
+			s, ok := value.(string)
+			return s, ok
+		case "$numberInt", "$numberLong":
+			s, ok := value.(string)
+			if !ok {
+				return nil, false
+			}
+
+			i, err := strconv.ParseInt(s, 10, 64)
+			return i, err == nil
+		case "$numberDouble":
+			s, ok := value.(string)
+			if !ok {
+				return nil, false
+			}
+
+			f, err := strconv.ParseFloat(s, 64)
+			return f, err == nil
+		case "$date":
+			return extendedJSONDate(value)
+		case "$binary":
+			if b, ok := value.(map[string]any); ok {
+				return extendedJSONBinary(b["base64"])
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// extendedJSONBinary decodes the base64 payload of a $binary wrapper
+func extendedJSONBinary(v any) (any, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, false
+	}
+
+	b, err := base64.StdEncoding.DecodeString(s)
+	return b, err == nil
+}
+
+// extendedJSONDate converts the value of a $date wrapper into a time.Time
+//
+// The relaxed form is an RFC 3339 string, whereas the canonical form is milliseconds since the epoch,
+// as a $numberLong wrapper or (in legacy output) a plain number.
+func extendedJSONDate(v any) (any, bool) {
+	if s, ok := v.(string); ok {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		return t, err == nil
+	}
+
+	if wrapped, ok := extendedJSONValue(v); ok {
+		v = wrapped
+	}
+
+	ms, err := asNumberType[int64](v)
+	if err != nil {
+		return nil, false
+	}
+
+	return time.UnixMilli(ms).UTC(), true
+}
@@ -0,0 +1,67 @@
+package mapreader
+
+import "fmt"
+
+// MustGet returns the value found at the given lookup path, panicking if it can't be found or converted
+//
+// The panic value is an error naming the path, which wraps the original error (naming the failing segment).
+// This suits test fixtures and configuration read during initialisation, where a missing value is a bug.
+func MustGet[T any](source map[string]any, path string) T {
+	return must(GetErr[T](source, path))(path)
+}
+
+// MustBool returns the bool found at the given lookup path, panicking if it can't be found or converted
+func MustBool(source map[string]any, path string) bool {
+	return must(BoolErr(source, path))(path)
+}
+
+// MustFloat64 returns the float64 found at the given lookup path, panicking if it can't be found or converted
+func MustFloat64(source map[string]any, path string) float64 {
+	return must(Float64Err(source, path))(path)
+}
+
+// MustInt returns the int found at the given lookup path, panicking if it can't be found or converted
+func MustInt(source map[string]any, path string) int {
+	return must(IntErr(source, path))(path)
+}
+
+// MustStr returns the string found at the given lookup path, panicking if it can't be found or converted
+func MustStr(source map[string]any, path string) string {
+	return must(StrErr(source, path))(path)
+}
+
+// MustRead is the Reader equivalent of mapreader.MustGet
+func MustRead[T any](r *Reader, path string) T {
+	return must(ReadErr[T](r, path))(path)
+}
+
+// MustBool is the Reader equivalent of mapreader.MustBool
+func (r *Reader) MustBool(path string) bool {
+	return must(r.BoolErr(path))(path)
+}
+
+// MustFloat64 is the Reader equivalent of mapreader.MustFloat64
+func (r *Reader) MustFloat64(path string) float64 {
+	return must(r.Float64Err(path))(path)
+}
+
+// MustInt is the Reader equivalent of mapreader.MustInt
+func (r *Reader) MustInt(path string) int {
+	return must(r.IntErr(path))(path)
+}
+
+// MustStr is the Reader equivalent of mapreader.MustStr
+func (r *Reader) MustStr(path string) string {
+	return must(r.StrErr(path))(path)
+}
+
+// must returns a function returning the result for the given path, or panicking with the error annotated by the path
+func must[T any](result T, err error) func(path string) T {
+	return func(path string) T {
+		if err != nil {
+			panic(fmt.Errorf("mapreader: path '%s': %w", path, err))
+		}
+
+		return result
+	}
+}
@@ -0,0 +1,62 @@
+package mapreader
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMust(t *testing.T) {
+	source := map[string]any{"name": "jo", "age": 42.0, "admin": true, "score": 1.5}
+
+	if MustStr(source, "name") != "jo" || MustInt(source, "age") != 42 || !MustBool(source, "admin") ||
+		MustFloat64(source, "score") != 1.5 || MustGet[string](source, "name") != "jo" {
+		t.Errorf("Expected Must variants to return the values found")
+	}
+
+	r := New(source)
+	if r.MustStr("name") != "jo" || r.MustInt("age") != 42 || !r.MustBool("admin") ||
+		r.MustFloat64("score") != 1.5 || MustRead[float64](r, "age") != 42 {
+		t.Errorf("Expected Reader Must variants to return the values found")
+	}
+
+	tests := map[string]struct {
+		fn          func()
+		expectedErr error
+		contains    string
+	}{
+		"missing key": {
+			fn:          func() { MustStr(source, "user.name") },
+			expectedErr: ErrKeyNotFound,
+			contains:    "path 'user.name': key not found: user",
+		},
+		"wrong type": {
+			fn:          func() { MustInt(source, "name") },
+			expectedErr: ErrUnexpectedType,
+			contains:    "path 'name'",
+		},
+		"generic": {
+			fn:          func() { MustGet[bool](source, "name.first.x") },
+			expectedErr: ErrEndOfNestedStructures,
+			contains:    "last key was 'first'",
+		},
+		"reader": {
+			fn:          func() { r.MustBool("missing") },
+			expectedErr: ErrKeyNotFound,
+			contains:    "path 'missing'",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				err, ok := recover().(error)
+				if !ok || !errors.Is(err, tc.expectedErr) || !strings.Contains(err.Error(), tc.contains) {
+					t.Errorf("Expected a panic with error: %v containing %q, but got: %v", tc.expectedErr, tc.contains, err)
+				}
+			}()
+
+			tc.fn()
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package mapreader
+
+import (
+	"fmt"
+	"net/netip"
+)
+
+// Addr returns the IP address found at the given lookup path, ignoring any errors
+//
+// If any error is encountered, it returns the zero netip.Addr.
+// Use mapreader.AddrErr if you would like errors to be returned
+func Addr(source map[string]any, path string) netip.Addr {
+	return withoutError(AddrErr(source, path))
+}
+
+// AddrDefault returns the IP address found at the given lookup path, or the default value
+//
+// The default is only returned for values that would otherwise error/aren't set.
+func AddrDefault(source map[string]any, path string, d netip.Addr) netip.Addr {
+	result, err := AddrErr(source, path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// AddrDefaultFunc returns the IP address found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func AddrDefaultFunc(source map[string]any, path string, d func() netip.Addr) netip.Addr {
+	result, err := AddrErr(source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// AddrDefaultOK returns the IP address found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func AddrDefaultOK(source map[string]any, path string, d netip.Addr) (netip.Addr, bool) {
+	result, err := AddrErr(source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// AddrErr returns the IP address found at the given lookup path, or returns an error
+//
+// Use mapreader.Addr if you would like to ignore errors
+// String values are parsed by netip.ParseAddr, returning ErrUnableToConvert for invalid addresses.
+func AddrErr(source map[string]any, path string) (netip.Addr, error) {
+	return get(source, path, &defaultOptions, asAddr)
+}
+
+// Prefix returns the IP network prefix found at the given lookup path, ignoring any errors
+//
+// If any error is encountered, it returns the zero netip.Prefix.
+// Use mapreader.PrefixErr if you would like errors to be returned
+func Prefix(source map[string]any, path string) netip.Prefix {
+	return withoutError(PrefixErr(source, path))
+}
+
+// PrefixDefault returns the IP network prefix found at the given lookup path, or the default value
+//
+// The default is only returned for values that would otherwise error/aren't set.
+func PrefixDefault(source map[string]any, path string, d netip.Prefix) netip.Prefix {
+	result, err := PrefixErr(source, path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// PrefixDefaultFunc returns the IP network prefix found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func PrefixDefaultFunc(source map[string]any, path string, d func() netip.Prefix) netip.Prefix {
+	result, err := PrefixErr(source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// PrefixDefaultOK returns the IP network prefix found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func PrefixDefaultOK(source map[string]any, path string, d netip.Prefix) (netip.Prefix, bool) {
+	result, err := PrefixErr(source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// PrefixErr returns the IP network prefix found at the given lookup path, or returns an error
+//
+// Use mapreader.Prefix if you would like to ignore errors
+// String values in CIDR notation (e.g. "10.0.0.0/8") are parsed by netip.ParsePrefix,
+// returning ErrUnableToConvert for invalid prefixes.
+func PrefixErr(source map[string]any, path string) (netip.Prefix, error) {
+	return get(source, path, &defaultOptions, asPrefix)
+}
+
+// asAddr converts an IP address string into a netip.Addr
+func asAddr(in any) (netip.Addr, error) {
+	switch v := in.(type) {
+	case string:
+		addr, err := netip.ParseAddr(v)
+		if err != nil {
+			return netip.Addr{}, fmt.Errorf("%w: %w", ErrUnableToConvert, err)
+		}
+
+		return addr, nil
+	case netip.Addr:
+		return v, nil
+	default:
+		return netip.Addr{}, fmt.Errorf("%w: expected an IP address string, got %T", ErrUnexpectedType, in)
+	}
+}
+
+// asPrefix converts an IP network prefix string into a netip.Prefix
+func asPrefix(in any) (netip.Prefix, error) {
+	switch v := in.(type) {
+	case string:
+		prefix, err := netip.ParsePrefix(v)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("%w: %w", ErrUnableToConvert, err)
+		}
+
+		return prefix, nil
+	case netip.Prefix:
+		return v, nil
+	default:
+		return netip.Prefix{}, fmt.Errorf("%w: expected an IP prefix string, got %T", ErrUnexpectedType, in)
+	}
+}
@@ -0,0 +1,97 @@
+package mapreader
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+)
+
+func TestAddrErr(t *testing.T) {
+	source := map[string]any{
+		"server": map[string]any{
+			"ipv4":    "10.0.0.1",
+			"ipv6":    "2001:db8::1",
+			"zone":    "fe80::1%eth0",
+			"invalid": "10.0.0.256",
+			"cidr":    "10.0.0.0/8",
+			"number":  float64(1),
+			"addr":    netip.MustParseAddr("127.0.0.1"),
+		},
+	}
+
+	tests := map[string]struct {
+		path        string
+		expected    string
+		expectedErr error
+	}{
+		"IPv4":             {path: "server.ipv4", expected: "10.0.0.1"},
+		"IPv6":             {path: "server.ipv6", expected: "2001:db8::1"},
+		"IPv6 with zone":   {path: "server.zone", expected: "fe80::1%eth0"},
+		"Addr value":       {path: "server.addr", expected: "127.0.0.1"},
+		"Out of range":     {path: "server.invalid", expectedErr: ErrUnableToConvert},
+		"Prefix":           {path: "server.cidr", expectedErr: ErrUnableToConvert},
+		"Unsupported type": {path: "server.number", expectedErr: ErrUnexpectedType},
+		"Missing key":      {path: "server.missing", expectedErr: ErrKeyNotFound},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := AddrErr(source, tc.path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if tc.expectedErr == nil && result.String() != tc.expected {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+
+	if addr := New(source).Addr("server.ipv4"); !addr.Is4() {
+		t.Errorf("Expected an IPv4 address but got: %v", addr)
+	}
+}
+
+func TestPrefixErr(t *testing.T) {
+	source := map[string]any{
+		"network": map[string]any{
+			"ipv4":     "10.0.0.0/8",
+			"ipv6":     "2001:db8::/32",
+			"host":     "10.0.0.1/24",
+			"bare":     "10.0.0.1",
+			"too_long": "10.0.0.0/33",
+			"number":   float64(1),
+		},
+	}
+
+	tests := map[string]struct {
+		path        string
+		expected    string
+		expectedErr error
+	}{
+		"IPv4":             {path: "network.ipv4", expected: "10.0.0.0/8"},
+		"IPv6":             {path: "network.ipv6", expected: "2001:db8::/32"},
+		"Host bits set":    {path: "network.host", expected: "10.0.0.1/24"},
+		"Bare address":     {path: "network.bare", expectedErr: ErrUnableToConvert},
+		"Too long":         {path: "network.too_long", expectedErr: ErrUnableToConvert},
+		"Unsupported type": {path: "network.number", expectedErr: ErrUnexpectedType},
+		"Missing key":      {path: "network.missing", expectedErr: ErrKeyNotFound},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := PrefixErr(source, tc.path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if tc.expectedErr == nil && result.String() != tc.expected {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+
+	if prefix := Prefix(source, "network.ipv4"); !prefix.Contains(netip.MustParseAddr("10.1.2.3")) {
+		t.Errorf("Expected 10.0.0.0/8 to contain 10.1.2.3 but got: %v", prefix)
+	}
+}
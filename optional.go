@@ -0,0 +1,56 @@
+package mapreader
+
+// Optional is the result of a lookup which may not have found a value, see mapreader.GetOpt
+type Optional[T any] struct {
+	value T
+	set   bool
+	err   error
+}
+
+// GetOpt returns the value found at the given lookup path as an Optional
+//
+// The Optional is set when a non-null value is found and converted successfully, so callers can distinguish
+// an absent value from a zero value, e.g. GetOpt[int](source, "retries").OrElse(3)
+func GetOpt[T any](source map[string]any, path string) Optional[T] {
+	return optional(getPtr(source, path, &defaultOptions, asType[T]))
+}
+
+// ReadOpt is the Reader equivalent of mapreader.GetOpt
+func ReadOpt[T any](r *Reader, path string) Optional[T] {
+	return optional(getPtr(r.source, path, &r.opts, asType[T]))
+}
+
+// IsSet reports whether a value was found
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// Value returns the value found, or the zero value of T if none was
+func (o Optional[T]) Value() T {
+	return o.value
+}
+
+// OrElse returns the value found, or the given default if none was
+func (o Optional[T]) OrElse(d T) T {
+	if !o.set {
+		return d
+	}
+
+	return o.value
+}
+
+// Err returns the error preventing a value from being found, such as a type mismatch
+//
+// Missing and null values aren't errors, so leave the Optional unset with a nil error.
+func (o Optional[T]) Err() error {
+	return o.err
+}
+
+// optional returns an Optional for the result of getPtr
+func optional[T any](value *T, err error) Optional[T] {
+	if value == nil {
+		return Optional[T]{err: err}
+	}
+
+	return Optional[T]{value: *value, set: true}
+}
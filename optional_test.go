@@ -0,0 +1,42 @@
+package mapreader
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGetOpt(t *testing.T) {
+	source := map[string]any{"retries": 0.0, "name": "jo", "null": nil}
+
+	tests := map[string]struct {
+		set         bool
+		value       float64
+		orElse      float64
+		expectedErr error
+	}{
+		"retries": {set: true, value: 0, orElse: 0},
+		"missing": {orElse: 3},
+		"null":    {orElse: 3},
+		"name":    {orElse: 3, expectedErr: ErrUnexpectedType},
+	}
+
+	for path, tc := range tests {
+		t.Run(path, func(t *testing.T) {
+			opt := GetOpt[float64](source, path)
+			if opt.IsSet() != tc.set || opt.Value() != tc.value || opt.OrElse(3) != tc.orElse {
+				t.Errorf(
+					"Expected: (%t, %v, %v) but got: (%t, %v, %v)",
+					tc.set, tc.value, tc.orElse, opt.IsSet(), opt.Value(), opt.OrElse(3),
+				)
+			}
+
+			if !errors.Is(opt.Err(), tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, opt.Err())
+			}
+		})
+	}
+
+	if result := ReadOpt[string](New(source), "name").OrElse("default"); result != "jo" {
+		t.Errorf("Expected: jo but got: %s", result)
+	}
+}
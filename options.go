@@ -0,0 +1,187 @@
+package mapreader
+
+import "time"
+
+// Options configures how lookup paths are resolved against a source
+type Options struct {
+	// UnwrapSingletonLists makes key lookups against a single element list descend into that element,
+	// and key lookups against a longer list descend into the first element holding the key.
+	// This suits HCL JSON representations, where blocks are expressed as lists of objects.
+	UnwrapSingletonLists bool
+
+	// UnwrapUnions makes lookups see through unions encoded as single key maps, e.g. {"string": "x"}.
+	// This suits Avro decoders, which encode union values keyed by the name of their branch type.
+	UnwrapUnions bool
+
+	// UnwrapAttributeValues makes lookups see through DynamoDB attribute values, e.g. {"S": "x"} or {"M": {...}}.
+	UnwrapAttributeValues bool
+
+	// Unwrappers are consulted, in order, when a value can't be traversed or converted as requested.
+	// Each returns the wrapped value and true if it recognises the given value as a wrapper.
+	Unwrappers []func(any) (any, bool)
+
+	// CaseInsensitiveKeys makes map keys that differ only by case match when no key matches exactly.
+	CaseInsensitiveKeys bool
+
+	// ParseNumbers makes the numeric getters accept numeric strings, e.g. "42" or "3.14", as well as numbers.
+	ParseNumbers bool
+
+	// DurationUnit is the unit of numeric durations, e.g. time.Millisecond or time.Second, defaulting to nanoseconds.
+	DurationUnit time.Duration
+
+	// UUIDParser, if set, parses the strings read as a UUID in place of mapreader.ParseUUID.
+	UUIDParser func(string) ([16]byte, error)
+
+	// SuggestKeys makes errors for missing keys suggest the closest existing keys, e.g. "did you mean 'address'?".
+	SuggestKeys bool
+
+	// ErrorFormatter, if set, controls the message of errors returned from failed lookups.
+	// Errors still match the package sentinel errors with errors.Is.
+	ErrorFormatter func(ErrorInfo) string
+
+	// RedactPaths are masked when a Reader is rendered by log/slog.
+	RedactPaths []string
+
+	// lookupError, if set, can replace the error returned from a failed lookup of the given path.
+	lookupError func(path string, err error) error
+}
+
+// Option modifies the Options used by a Reader
+type Option func(*Options)
+
+// defaultOptions are used by the package level lookup functions, and are the starting point of every Reader
+var defaultOptions Options
+
+// SetDefaults replaces the Options used by the package level lookup functions, and by Readers created afterwards
+//
+// This lets an application opt into behaviours such as key suggestions everywhere, without passing options
+// to every call site. It isn't safe to call concurrently with lookups, so should be called during initialisation,
+// e.g. mapreader.SetDefaults(mapreader.Options{SuggestKeys: true}) from main or an init function.
+func SetDefaults(opts Options) {
+	defaultOptions = opts
+}
+
+// Defaults returns the Options used by the package level lookup functions
+func Defaults() Options {
+	return defaultOptions
+}
+
+// WithAttributeValueUnwrap makes lookups see through DynamoDB attribute values, so items can be read with normal paths
+//
+// e.g. with this option, Int(source, "order.total") will find 42 in {"order": {"M": {"total": {"N": "42"}}}}
+// Both the DynamoDB JSON shape and the AWS SDK v2 AttributeValueMember types are supported.
+// Maps and lists returned from a lookup have all attribute values within them unwrapped.
+func WithAttributeValueUnwrap() Option {
+	return func(o *Options) {
+		o.UnwrapAttributeValues = true
+	}
+}
+
+// WithCaseInsensitiveKeys makes map keys match regardless of case, when no key matches exactly
+//
+// e.g. with this option, "user.firstname" will find the name in {"User": {"FirstName": "Jo"}}
+// This suits payloads from services which differ only in the casing of their keys.
+// If several keys differ only by case, the first in sorted order is used.
+func WithCaseInsensitiveKeys() Option {
+	return func(o *Options) {
+		o.CaseInsensitiveKeys = true
+	}
+}
+
+// WithDurationUnit sets the unit that numeric values are counted in when read as a time.Duration
+//
+// e.g. with WithDurationUnit(time.Millisecond), DurationErr(source, "timeout") returns 1.5s for {"timeout": 1500}
+// Strings such as "1h30m" are unaffected, and are always parsed by time.ParseDuration.
+func WithDurationUnit(unit time.Duration) Option {
+	return func(o *Options) {
+		o.DurationUnit = unit
+	}
+}
+
+// WithErrorFormatter sets a function controlling the message of errors returned from failed lookups
+//
+// This allows errors to be translated, or stripped of internal terminology, before being shown to end users.
+// The returned errors still wrap the original, so errors.Is(err, mapreader.ErrKeyNotFound) continues to work.
+func WithErrorFormatter(fn func(ErrorInfo) string) Option {
+	return func(o *Options) {
+		o.ErrorFormatter = fn
+	}
+}
+
+// WithKeySuggestions makes errors for missing keys suggest the closest existing keys of the same map
+//
+// e.g. with this option, looking up "user.adress" returns "key not found: adress, did you mean 'address'?"
+// Suggestions are only made for keys within a small edit distance of the missing key.
+func WithKeySuggestions() Option {
+	return func(o *Options) {
+		o.SuggestKeys = true
+	}
+}
+
+// WithNumberParsing makes the numeric getters, such as Int, Float64 and Number, parse numeric strings
+//
+// e.g. with this option, IntErr(source, "age") returns 42 for {"age": "42"}, as form encoded data and templated YAML
+// often quote numbers. Parsed values must still be equal once converted, so "3.14" can't be read as an int.
+// Alternatively, mapreader.IntFlexible and mapreader.Float64Flexible parse numeric strings without this option.
+func WithNumberParsing() Option {
+	return func(o *Options) {
+		o.ParseNumbers = true
+	}
+}
+
+// WithRedactedPaths masks the values at the given paths when a Reader is rendered by log/slog
+//
+// Paths are matched as by mapreader.LogValue, so may use "*" to match every key or index of a level.
+func WithRedactedPaths(paths ...string) Option {
+	return func(o *Options) {
+		o.RedactPaths = append(o.RedactPaths, paths...)
+	}
+}
+
+// WithSingletonListUnwrap makes key lookups against a single element list descend into that element
+//
+// e.g. with this option, "resource.web.ami" will find the ami of {"resource": {"web": [{"ami": "..."}]}}
+// Key lookups against lists with more than one element descend into the first element holding the key,
+// so "variable.zone.default" finds "a" in {"variable": [{"region": {...}}, {"zone": {"default": "a"}}]}.
+// Explicit indexes still select an element by its position.
+func WithSingletonListUnwrap() Option {
+	return func(o *Options) {
+		o.UnwrapSingletonLists = true
+	}
+}
+
+// WithUnionUnwrap makes lookups see through unions encoded as single key maps, as produced by Avro decoders
+//
+// e.g. with this option, Str(source, "user.email") will find "x" in {"user": {"email": {"string": "x"}}}
+// and "user.address.city" will traverse {"user": {"address": {"com.example.Address": {"city": "..."}}}}.
+// Unions are only unwrapped where a key lookup or type conversion would otherwise fail.
+func WithUnionUnwrap() Option {
+	return func(o *Options) {
+		o.UnwrapUnions = true
+	}
+}
+
+// WithUUIDParser sets the function used to parse strings read as a UUID, in place of mapreader.ParseUUID
+//
+// This allows other forms to be accepted, e.g. to also accept braced and URN forms using github.com/google/uuid:
+//
+//	mapreader.WithUUIDParser(func(s string) ([16]byte, error) { u, err := uuid.Parse(s); return u, err })
+//
+// Errors returned by the parser wrap ErrUnableToConvert.
+func WithUUIDParser(parser func(string) ([16]byte, error)) Option {
+	return func(o *Options) {
+		o.UUIDParser = parser
+	}
+}
+
+// WithUnwrapper adds a custom unwrapper, letting lookups see through vendor specific envelopes
+//
+// The unwrapper is consulted when a value can't be traversed by the next path segment,
+// or can't be converted into the requested type. It should return the wrapped value and true
+// if it recognises the value as a wrapper, otherwise false. Unwrappers are tried in the order given,
+// and must not return the value they were given, or lookups will never finish.
+func WithUnwrapper(fn func(any) (any, bool)) Option {
+	return func(o *Options) {
+		o.Unwrappers = append(o.Unwrappers, fn)
+	}
+}
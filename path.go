@@ -0,0 +1,354 @@
+package mapreader
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pathSpecialChars are the characters escaped by QuoteSegment
+const pathSpecialChars = `\.[]*#|:`
+
+// Path is a lookup path built one segment at a time, see mapreader.P
+type Path string
+
+// P starts building a Path from the given keys, each encoded as by mapreader.PathOf
+//
+// e.g. P().Key("a").Index(0).Key("b.c").String() returns `a.0.b\.c`
+// Paths are immutable, so a common prefix can be safely extended in different directions.
+func P(keys ...any) Path {
+	return Path(PathOf(keys...))
+}
+
+// Key returns the path extended by a map key or struct field, quoted so that it's always a single segment
+func (p Path) Key(key string) Path {
+	return p.join(QuoteSegment(key))
+}
+
+// Index returns the path extended by a slice index, which may be negative to count back from the end of the slice
+func (p Path) Index(i int) Path {
+	return p.join(strconv.Itoa(i))
+}
+
+// All returns the path extended by a wildcard segment, matching every element of a slice or value of a map
+//
+// Paths containing wildcards are looked up with mapreader.GetAll.
+func (p Path) All() Path {
+	return p.join("*")
+}
+
+// Where returns the path extended by a segment selecting the first element of a slice whose field has the given value
+//
+// e.g. P("users").Where("id", 42) selects {"id": 42} from {"users": [{"id": 7}, {"id": 42}]}
+// The field may itself be a lookup path, evaluated against each element.
+// Both the field and value are quoted, so neither can change the structure of the path.
+func (p Path) Where(field string, value any) Path {
+	return p.join("[" + QuoteSegment(field) + "=" + QuoteSegment(fmt.Sprint(value)) + "]")
+}
+
+// String returns the encoded lookup path
+func (p Path) String() string {
+	return string(p)
+}
+
+// join appends an encoded segment to the path
+func (p Path) join(segment string) Path {
+	if p == "" {
+		return Path(segment)
+	}
+
+	return p + "." + Path(segment)
+}
+
+// PathOf builds a lookup path from the given keys, safely encoding each of them as a single segment
+//
+// Integer keys become slice indexes, string keys are quoted with mapreader.QuoteSegment,
+// and any other keys are quoted using their default formatting.
+// e.g. PathOf("users", "dan@example.com", 0) returns `users.dan@example\.com.0`
+func PathOf(keys ...any) string {
+	var b strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte('.')
+		}
+
+		switch v := k.(type) {
+		case string:
+			b.WriteString(QuoteSegment(v))
+		case int:
+			b.WriteString(strconv.Itoa(v))
+		case int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			fmt.Fprint(&b, v)
+		default:
+			b.WriteString(QuoteSegment(fmt.Sprint(v)))
+		}
+	}
+
+	return b.String()
+}
+
+// ResolvePath resolves a path relative to the given base path, returning the equivalent absolute path
+//
+// Each leading ".." of the relative path moves up one level from the base, so from "items.3.price",
+// "..quantity" resolves to "items.3.quantity" and "....0.price" resolves to "items.0.price".
+// Relative paths without a leading ".." descend from the base, so from "items.3", "price" resolves to "items.3.price".
+// Moving up beyond the root of the source returns ErrInvalidPath.
+func ResolvePath(base, rel string) (string, error) {
+	var segments []string
+	if base != "" {
+		segments = splitRawPath(base)
+	}
+
+	for strings.HasPrefix(rel, "..") {
+		if len(segments) == 0 {
+			return "", fmt.Errorf("%w: '%s' moves above the root of '%s'", ErrInvalidPath, rel, base)
+		}
+
+		segments = segments[:len(segments)-1]
+		rel = rel[2:]
+	}
+	rel = strings.TrimPrefix(rel, ".")
+
+	path := strings.Join(segments, ".")
+
+	switch {
+	case rel == "":
+		return path, nil
+	case path == "":
+		return rel, nil
+	default:
+		return path + "." + rel, nil
+	}
+}
+
+// QuoteSegment encodes an arbitrary key as a single lookup path segment
+//
+// Characters with a special meaning in lookup paths (such as '.', or a leading '-') are escaped with a backslash,
+// so untrusted keys can't change the structure of a path they're used in. Escaped segments are always looked up
+// as literal keys, so a quoted "[id=1]", "1:3" or "-1" is never treated as a selector, range or index of a slice.
+// e.g. QuoteSegment("example.com") returns `example\.com`
+func QuoteSegment(key string) string {
+	if !strings.ContainsAny(key, pathSpecialChars) && !strings.HasPrefix(key, "-") {
+		return key
+	}
+
+	var b strings.Builder
+	b.Grow(len(key) + 2)
+	for i := 0; i < len(key); i++ {
+		if strings.IndexByte(pathSpecialChars, key[i]) >= 0 || i == 0 && key[i] == '-' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(key[i])
+	}
+
+	return b.String()
+}
+
+// cutSegment splits the first segment from a lookup path, returning the unescaped segment and the rest of the path
+//
+// A '[' starts a new bracketed segment (see cutBracketSegment), so "a[0]" is equivalent to "a.0".
+// Segments without escapes are split without allocating.
+func cutSegment(path string) (key, rest string, more bool) {
+	key, rest, more, _ = cutLiteralSegment(path)
+	return key, rest, more
+}
+
+// cutLiteralSegment is cutSegment, also reporting whether the segment was escaped or quoted
+//
+// Such segments are literal keys, so are never treated as selectors, ranges or negative indexes of a slice,
+// e.g. `\[id=1\]` and ["[id=1]"] both look up the map key "[id=1]".
+func cutLiteralSegment(path string) (key, rest string, more, literal bool) {
+	if strings.HasPrefix(path, "[") {
+		return cutBracketSegment(path)
+	}
+
+	i := strings.IndexAny(path, `.\[`)
+	if i < 0 {
+		return path, "", false, false
+	}
+
+	switch path[i] {
+	case '.':
+		return path[:i], path[i+1:], true, false
+	case '[':
+		return path[:i], path[i:], true, false
+	}
+
+	end := len(path)
+scan:
+	for ; i < len(path); i++ {
+		switch path[i] {
+		case '\\':
+			i++
+		case '.':
+			end, rest, more = i, path[i+1:], true
+			break scan
+		case '[':
+			end, rest, more = i, path[i:], true
+			break scan
+		}
+	}
+
+	return unescapeSegment(path[:end]), rest, more, true
+}
+
+// cutBracketSegment splits a bracketed segment from the start of a lookup path
+//
+// Quoted keys (e.g. ["weird.key"] or ['weird.key']) are returned unquoted, and indexes or ranges (e.g. [0] or [1:3])
+// are returned without their brackets.
+// Anything else (e.g. "[version=1.5]") is returned along with its brackets, leaving its meaning to the lookup.
+// Dots within brackets don't end a segment, and the segment may be followed by a '.', a further '[', or nothing.
+func cutBracketSegment(path string) (key, rest string, more, quoted bool) {
+	end, escaped := -1, false
+	quoted = len(path) > 1 && (path[1] == '"' || path[1] == '\'')
+	if quoted {
+		for i := 2; i < len(path); i++ {
+			if path[i] == '\\' {
+				escaped = true
+				i++
+			} else if path[i] == path[1] {
+				if i+1 < len(path) && path[i+1] == ']' {
+					key, end = path[2:i], i+1
+				}
+				break
+			}
+		}
+	} else {
+		depth := 0
+	scan:
+		for i := 0; i < len(path); i++ {
+			switch path[i] {
+			case '\\':
+				escaped = true
+				i++
+			case '[':
+				depth++
+			case ']':
+				if depth--; depth == 0 {
+					key, end = path[:i+1], i
+					break scan
+				}
+			}
+		}
+	}
+
+	if end < 0 {
+		return unescapeSegment(path), "", false, false
+	}
+
+	if !quoted {
+		if inner := key[1 : len(key)-1]; isIndex(inner) || isRange(inner) {
+			key = inner
+		}
+	}
+
+	if escaped {
+		key = unescapeSegment(key)
+	}
+
+	rest = path[end+1:]
+	if strings.HasPrefix(rest, ".") {
+		return key, rest[1:], true, quoted
+	}
+
+	return key, rest, rest != "", quoted
+}
+
+// cutModifiers splits the modifiers (e.g. "|lower") from the end of a lookup path
+//
+// A '|' within brackets, or escaped with a backslash, doesn't start a modifier.
+func cutModifiers(path string) (base string, modifiers []string) {
+	if strings.IndexByte(path, '|') < 0 {
+		return path, nil
+	}
+
+	start, depth := -1, 0
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			depth = max(0, depth-1)
+		case '|':
+			if depth > 0 {
+				continue
+			}
+
+			if start < 0 {
+				base = path[:i]
+			} else {
+				modifiers = append(modifiers, path[start:i])
+			}
+			start = i + 1
+		}
+	}
+
+	if start < 0 {
+		return path, nil
+	}
+
+	return base, append(modifiers, path[start:])
+}
+
+// cutLastSegment splits the final segment from a lookup path, returning the path of its parent and the unescaped segment
+func cutLastSegment(path string) (parent, key string, hasParent bool) {
+	for rest := path; ; {
+		key, next, more := cutSegment(rest)
+		if !more {
+			return strings.TrimSuffix(path[:len(path)-len(rest)], "."), key, len(rest) < len(path)
+		}
+
+		rest = next
+	}
+}
+
+// joinPath appends a key to a lookup path, quoting it as a single segment
+func joinPath(path string, key string) string {
+	if path == "" {
+		return QuoteSegment(key)
+	}
+
+	return path + "." + QuoteSegment(key)
+}
+
+// splitPath splits a lookup path into its unescaped segments
+func splitPath(path string) []string {
+	var segments []string
+	for key, rest, more := cutSegment(path); ; key, rest, more = cutSegment(rest) {
+		segments = append(segments, key)
+		if !more {
+			return segments
+		}
+	}
+}
+
+// splitRawPath splits a lookup path into its segments as written, keeping any escapes, quotes and brackets
+//
+// Joining the segments with '.' gives a path with the same meaning, so wildcards and selectors are preserved.
+func splitRawPath(path string) []string {
+	var segments []string
+	for remaining := path; ; {
+		_, rest, more := cutSegment(remaining)
+		segments = append(segments, strings.TrimSuffix(remaining[:len(remaining)-len(rest)], "."))
+		if !more {
+			return segments
+		}
+		remaining = rest
+	}
+}
+
+// unescapeSegment removes the backslash escapes from a path segment
+func unescapeSegment(segment string) string {
+	var b strings.Builder
+	b.Grow(len(segment))
+	for i := 0; i < len(segment); i++ {
+		if segment[i] == '\\' && i+1 < len(segment) {
+			i++
+		}
+		b.WriteByte(segment[i])
+	}
+
+	return b.String()
+}
@@ -0,0 +1,396 @@
+package mapreader
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestQuoteSegment(t *testing.T) {
+	tests := map[string]string{
+		"plain":        "plain",
+		"example.com":  `example\.com`,
+		`back\slash`:   `back\\slash`,
+		"items[0]":     `items\[0\]`,
+		"*#|":          `\*\#\|`,
+		"":             "",
+		"unicode.café": `unicode\.café`,
+		"1:3":          `1\:3`,
+		"-1":           `\-1`,
+		"a-1":          "a-1",
+	}
+
+	for key, expected := range tests {
+		t.Run(key, func(t *testing.T) {
+			if result := QuoteSegment(key); result != expected {
+				t.Errorf("Expected: %s but got: %s", expected, result)
+			}
+
+			if segments := splitPath(QuoteSegment(key)); !reflect.DeepEqual(segments, []string{key}) {
+				t.Errorf("Expected: [%s] but got: %v", key, segments)
+			}
+		})
+	}
+}
+
+func TestPathOf(t *testing.T) {
+	source := map[string]any{
+		"users": map[string]any{
+			"dan@example.com": []any{"first", "second"},
+			"3":               "three",
+			`a\b`:             "backslash",
+		},
+	}
+
+	tests := []struct {
+		keys     []any
+		path     string
+		expected string
+	}{
+		{keys: []any{"users", "dan@example.com", 1}, path: `users.dan@example\.com.1`, expected: "second"},
+		{keys: []any{"users", "3"}, path: "users.3", expected: "three"},
+		{keys: []any{"users", `a\b`}, path: `users.a\\b`, expected: "backslash"},
+		{keys: []any{"users", "dan@example.com", uint8(0)}, path: `users.dan@example\.com.0`, expected: "first"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			path := PathOf(tc.keys...)
+			if path != tc.path {
+				t.Errorf("Expected: %s but got: %s", tc.path, path)
+			}
+
+			if result, err := StrErr(source, path); err != nil || result != tc.expected {
+				t.Errorf("Expected: %s but got: %s (%v)", tc.expected, result, err)
+			}
+		})
+	}
+}
+
+func TestSplitPath(t *testing.T) {
+	tests := map[string][]string{
+		"a.b.c":               {"a", "b", "c"},
+		`a\.b.c`:              {"a.b", "c"},
+		`a\\.b`:               {`a\`, "b"},
+		`trailing\`:           {`trailing\`},
+		"":                    {""},
+		"a..b":                {"a", "", "b"},
+		`\x.y`:                {"x", "y"},
+		"a[0].b":              {"a", "0", "b"},
+		`a["weird.key"][0].b`: {"a", "weird.key", "0", "b"},
+		`a['it\'s'].b`:        {"a", "it's", "b"},
+		"a.[id=1.5].b":        {"a", "[id=1.5]", "b"},
+		"a[id=1][0]":          {"a", "[id=1]", "0"},
+		`a["0"]`:              {"a", "0"},
+		"a[unterminated.b":    {"a", "[unterminated.b"},
+		`a["unterminated].b`:  {"a", `["unterminated].b`},
+	}
+
+	for path, expected := range tests {
+		if result := splitPath(path); !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected: %q but got: %q (%s)", expected, result, path)
+		}
+	}
+}
+
+func TestBracketSegments(t *testing.T) {
+	source := map[string]any{
+		"a": map[string]any{
+			"weird.key": []any{map[string]any{"b": "weird"}},
+			"it's":      "quoted",
+			"[x]":       "brackets",
+		},
+		"users":  []any{map[string]any{"id": 42.0, "name": "Dan"}},
+		"matrix": []any{[]any{1.0, 2.0}, []any{3.0, 4.0}},
+	}
+
+	tests := map[string]struct {
+		expected    any
+		expectedErr error
+	}{
+		`a["weird.key"][0].b`: {expected: "weird"},
+		`a['weird.key'].0.b`:  {expected: "weird"},
+		`a["it's"]`:           {expected: "quoted"},
+		`a['it\'s']`:          {expected: "quoted"},
+		`a["[x]"]`:            {expected: "brackets"},
+		`users[id=42].name`:   {expected: "Dan"},
+		"users[0].name":       {expected: "Dan"},
+		"matrix[1][0]":        {expected: 3.0},
+		`a["missing"]`:        {expectedErr: ErrKeyNotFound},
+		"users[5].name":       {expectedErr: ErrIndexOutOfBounds},
+	}
+
+	for path, tc := range tests {
+		t.Run(path, func(t *testing.T) {
+			result, err := GetErr[any](source, path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestResolvePath(t *testing.T) {
+	tests := []struct {
+		base, rel   string
+		expected    string
+		expectedErr error
+	}{
+		{base: "items.3.price", rel: "..quantity", expected: "items.3.quantity"},
+		{base: "items.3.price", rel: "....0.price", expected: "items.0.price"},
+		{base: "items.3.price", rel: "..", expected: "items.3"},
+		{base: "items.3", rel: "price", expected: "items.3.price"},
+		{base: "", rel: "items", expected: "items"},
+		{base: "items", rel: "..", expected: ""},
+		{base: `a\.b.c`, rel: "..d", expected: `a\.b.d`},
+		{base: "users.*.profile", rel: "..email", expected: "users.*.email"},
+		{base: "users.[id=42].profile", rel: "..email", expected: "users.[id=42].email"},
+		{base: `a["b.c"].d`, rel: "..e", expected: `a.["b.c"].e`},
+		{base: "items", rel: "....", expectedErr: ErrInvalidPath},
+		{base: "", rel: "..items", expectedErr: ErrInvalidPath},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.base+" "+tc.rel, func(t *testing.T) {
+			result, err := ResolvePath(tc.base, tc.rel)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("Expected: %s but got: %s", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestEscapedKeys(t *testing.T) {
+	source := map[string]any{
+		"metadata": map[string]any{
+			"name": "web",
+			"annotations": map[string]any{
+				"kubernetes.io/ingress.class": "nginx",
+				"example.com/weight[0]":       "10",
+			},
+			"labels": map[string]any{"app.kubernetes.io/name": "web"},
+		},
+		"metadata.name": "flat",
+	}
+
+	tests := map[string]struct {
+		expected    string
+		expectedErr error
+	}{
+		`metadata\.name`: {expected: "flat"},
+		"metadata.name":  {expected: "web"},
+		`metadata.annotations.kubernetes\.io/ingress\.class`: {expected: "nginx"},
+		`metadata.annotations.example\.com/weight\[0\]`:      {expected: "10"},
+		`metadata.labels.app\.kubernetes\.io/name`:           {expected: "web"},
+		"metadata.labels.app.kubernetes.io/name":             {expectedErr: ErrKeyNotFound},
+	}
+
+	for path, tc := range tests {
+		t.Run(path, func(t *testing.T) {
+			result, err := StrErr(source, path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("Expected: %s but got: %s", tc.expected, result)
+			}
+		})
+	}
+
+	path := PathOf("metadata", "labels", "app.kubernetes.io/version")
+	if err := SetErr(source, path, "1.2"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result := Str(source, `metadata.labels.app\.kubernetes\.io/version`); result != "1.2" {
+		t.Errorf("Expected: 1.2 but got: %s", result)
+	}
+}
+
+func TestLiteralSegments(t *testing.T) {
+	source := map[string]any{
+		"keys": map[string]any{"[role=admin]": "selector", "1:3": "range", "-1": "negative", "0": "zero"},
+		"users": []any{
+			map[string]any{"role": "admin", "name": "jo"},
+			map[string]any{"role": "user", "name": "dan"},
+		},
+		"typed": []string{"a", "b"},
+	}
+
+	tests := []struct {
+		path        string
+		expected    any
+		expectedErr error
+	}{
+		{path: P("keys").Key("[role=admin]").String(), expected: "selector"},
+		{path: P("keys").Key("1:3").String(), expected: "range"},
+		{path: P("keys").Key("-1").String(), expected: "negative"},
+		{path: `keys.["-1"]`, expected: "negative"},
+		{path: "users.[role=admin].name", expected: "jo"},
+		{path: P("users").Key("[role=admin]").String() + ".name", expectedErr: ErrNonIntegerSliceAccess},
+		{path: `users.["[role=admin]"].name`, expectedErr: ErrNonIntegerSliceAccess},
+		{path: "users.-1.name", expected: "dan"},
+		{path: P("users").Key("-1").String() + ".name", expectedErr: ErrNonIntegerSliceAccess},
+		{path: P("users").Key("0:1").String(), expectedErr: ErrNonIntegerSliceAccess},
+		{path: `users.["1"].name`, expected: "dan"},
+		{path: P("typed").Key("-1").String(), expectedErr: ErrNonIntegerSliceAccess},
+		{path: P("typed").Key("0:1").String(), expectedErr: ErrNonIntegerSliceAccess},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			result, err := GetErr[any](source, tc.path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if tc.expectedErr == nil && result != tc.expected {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestSliceRanges(t *testing.T) {
+	source := map[string]any{
+		"items":  []any{0.0, 1.0, 2.0, 3.0, 4.0},
+		"typed":  []int{0, 1, 2, 3},
+		"array":  [3]string{"a", "b", "c"},
+		"nested": []any{map[string]any{"tags": []any{"x", "y", "z"}}},
+		"ranges": map[string]any{"1:2": "literal"},
+	}
+
+	tests := map[string]struct {
+		expected    any
+		expectedErr error
+	}{
+		"items.1:4":        {expected: []any{1.0, 2.0, 3.0}},
+		"items.2:":         {expected: []any{2.0, 3.0, 4.0}},
+		"items.:2":         {expected: []any{0.0, 1.0}},
+		"items.:":          {expected: []any{0.0, 1.0, 2.0, 3.0, 4.0}},
+		"items.-2:":        {expected: []any{3.0, 4.0}},
+		"items.1:-1":       {expected: []any{1.0, 2.0, 3.0}},
+		"items.3:10":       {expected: []any{3.0, 4.0}},
+		"items.4:1":        {expected: []any{}},
+		"items[1:3]":       {expected: []any{1.0, 2.0}},
+		"items.1:3.0":      {expected: 1.0},
+		"typed.1:3":        {expected: []int{1, 2}},
+		"array.1:":         {expected: []string{"b", "c"}},
+		"nested.0.tags.1:": {expected: []any{"y", "z"}},
+		`ranges.1:2`:       {expected: "literal"},
+		"items.a:b":        {expectedErr: ErrNonIntegerSliceAccess},
+	}
+
+	for path, tc := range tests {
+		t.Run(path, func(t *testing.T) {
+			result, err := GetErr[any](source, path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if tc.expectedErr == nil && !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+
+	if result := Slice[float64](source, "items.1:3"); !reflect.DeepEqual(result, []float64{1, 2}) {
+		t.Errorf("Expected: [1 2] but got: %v", result)
+	}
+}
+
+func TestLengthSegment(t *testing.T) {
+	source := map[string]any{
+		"items":  []any{1.0, 2.0, 3.0},
+		"user":   map[string]any{"name": "jo", "email": "jo@example.com"},
+		"typed":  map[string]int{"a": 1},
+		"ptr":    &[]string{"a", "b"},
+		"hashes": map[string]any{"#": "literal"},
+		"name":   "jo",
+	}
+
+	tests := map[string]struct {
+		expected    int
+		expectedErr error
+	}{
+		"items.#":    {expected: 3},
+		"items.1:.#": {expected: 2},
+		"user.#":     {expected: 2},
+		"typed.#":    {expected: 1},
+		"ptr.#":      {expected: 2},
+		"#":          {expected: 6},
+		"name.#":     {expectedErr: ErrUnexpectedType},
+		"missing.#":  {expectedErr: ErrKeyNotFound},
+		`hashes.#`:   {expected: 1},
+	}
+
+	for path, tc := range tests {
+		t.Run(path, func(t *testing.T) {
+			result, err := IntErr(source, path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("Expected: %d but got: %d", tc.expected, result)
+			}
+		})
+	}
+
+	if result := Str(source, `hashes.\#`); result != "literal" {
+		t.Errorf("Expected: literal but got: %s", result)
+	}
+}
+
+func TestPathBuilder(t *testing.T) {
+	source := map[string]any{
+		"a":   []any{map[string]any{"b.c": "dotted"}},
+		"odd": []any{map[string]any{"x]": 2}, map[string]any{"x]": 1, "name": "bracket"}},
+		"users": []any{
+			map[string]any{"id": 7.0, "profile": map[string]any{"name": "Jo"}},
+			map[string]any{"id": 42.0, "profile": map[string]any{"name": "Dan"}},
+		},
+	}
+
+	tests := []struct {
+		path     Path
+		encoded  string
+		expected string
+	}{
+		{path: P().Key("a").Index(0).Key("b.c"), encoded: `a.0.b\.c`, expected: "dotted"},
+		{path: P("a", 0, "b.c"), encoded: `a.0.b\.c`, expected: "dotted"},
+		{path: P("users").Index(-1).Key("profile").Key("name"), encoded: "users.-1.profile.name", expected: "Dan"},
+		{path: P("users").Where("id", 7).Key("profile").Key("name"), encoded: "users.[id=7].profile.name", expected: "Jo"},
+		{path: P("users").Where("profile.name", "Dan").Key("id"), encoded: `users.[profile\.name=Dan].id`, expected: "42"},
+		{path: P("odd").Where("x]", 1).Key("name"), encoded: `odd.[x\]=1].name`, expected: "bracket"},
+		{path: P().Key("users|upper").Key("x"), encoded: `users\|upper.x`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.encoded, func(t *testing.T) {
+			if tc.path.String() != tc.encoded {
+				t.Errorf("Expected: %s but got: %s", tc.encoded, tc.path)
+			}
+
+			if result := Get[any](source, tc.path.String()); tc.expected != "" && fmt.Sprint(result) != tc.expected {
+				t.Errorf("Expected: %s but got: %s", tc.expected, result)
+			}
+		})
+	}
+
+	base := P("users")
+	names := []string{base.All().Key("profile").Key("name").String(), base.Index(0).String()}
+	if names[0] != "users.*.profile.name" || names[1] != "users.0" {
+		t.Errorf("Expected paths to extend a common prefix independently but got: %v", names)
+	}
+}
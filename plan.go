@@ -0,0 +1,160 @@
+package mapreader
+
+import "errors"
+
+// Plan is a set of lookup paths compiled into a prefix trie, so they can all be resolved in a single traversal
+//
+// Plans are safe for concurrent use once compiled.
+type Plan struct {
+	paths     []string
+	modifiers [][]string
+	root      *planNode
+}
+
+// Result is the outcome of resolving a single lookup path of a Plan
+type Result struct {
+	Path  string
+	Value any
+	Err   error
+}
+
+// planNode is a node of a Plan's trie, tracking the paths that end at it and those that pass through it
+//
+// Children are keyed by their segments as written, so an escaped `\#` isn't confused with a final "#".
+// Paths ending in a final "#" are tracked by the node whose length they return, rather than by a child.
+type planNode struct {
+	children map[string]*planNode
+	ends     []int
+	lengths  []int
+	all      []int
+}
+
+// CompileSet compiles many lookup paths into a Plan
+//
+// Paths sharing a prefix are only traversed once when the Plan is run, so resolving
+// thousands of overlapping paths doesn't repeatedly walk the same parts of the source.
+// Each path resolves as it would with mapreader.GetErr, including any modifiers and a final "#" segment.
+func CompileSet(paths []string) *Plan {
+	p := &Plan{paths: paths, modifiers: make([][]string, len(paths)), root: &planNode{}}
+	for i, path := range paths {
+		base, modifiers := cutModifiers(path)
+		p.modifiers[i] = modifiers
+
+		node := p.root
+		segments := splitRawPath(base)
+		isLength := segments[len(segments)-1] == "#"
+		if isLength {
+			segments = segments[:len(segments)-1]
+		}
+
+		for _, segment := range segments {
+			node.all = append(node.all, i)
+
+			child, ok := node.children[segment]
+			if !ok {
+				if node.children == nil {
+					node.children = make(map[string]*planNode)
+				}
+
+				child = &planNode{}
+				node.children[segment] = child
+			}
+			node = child
+		}
+
+		node.all = append(node.all, i)
+		if isLength {
+			node.lengths = append(node.lengths, i)
+		} else {
+			node.ends = append(node.ends, i)
+		}
+	}
+
+	return p
+}
+
+// Run resolves every path of the Plan against the source, returning a result per path in the order they were compiled
+func (p *Plan) Run(source map[string]any) []Result {
+	return p.run(source, &defaultOptions)
+}
+
+// RunReader resolves every path of the Plan against the source of a Reader, using its options
+func (p *Plan) RunReader(r *Reader) []Result {
+	return p.run(r.source, &r.opts)
+}
+
+// run resolves every path of the Plan using the given options
+func (p *Plan) run(source map[string]any, opts *Options) []Result {
+	results := make([]Result, len(p.paths))
+	for i, path := range p.paths {
+		results[i].Path = path
+	}
+
+	p.resolveLengths(results, source, p.root, opts)
+	for segment, child := range p.root.children {
+		p.resolve(results, source, segment, child, opts)
+	}
+
+	for i, modifiers := range p.modifiers {
+		if len(modifiers) == 0 || results[i].Err != nil {
+			continue
+		}
+
+		value, err := applyModifiers(results[i].Value, modifiers, opts)
+		if err != nil {
+			results[i] = Result{Path: p.paths[i], Err: lookupError(p.paths[i], err, opts)}
+			continue
+		}
+		results[i].Value = value
+	}
+
+	return results
+}
+
+// resolve steps from the current value into the given segment, then recursively resolves the children of the node
+func (p *Plan) resolve(results []Result, current any, segment string, node *planNode, opts *Options) {
+	key, _, _, literal := cutLiteralSegment(segment)
+	next, err := stepSegment(current, key, literal, opts)
+	if err != nil {
+		for _, i := range node.all {
+			results[i].Err = lookupError(p.paths[i], err, opts)
+		}
+
+		// As with GetErr, paths ending in a key against a value that can't be traversed return that value
+		if errors.Is(err, ErrEndOfNestedStructures) {
+			for _, i := range node.ends {
+				results[i] = Result{Path: p.paths[i], Value: current}
+			}
+		}
+
+		return
+	}
+
+	for _, i := range node.ends {
+		results[i].Value = foundValue(next, opts)
+	}
+
+	if len(node.children) == 0 && len(node.lengths) == 0 {
+		return
+	}
+
+	next = traversable(next, opts)
+
+	p.resolveLengths(results, next, node, opts)
+	for k, child := range node.children {
+		p.resolve(results, next, k, child, opts)
+	}
+}
+
+// resolveLengths resolves the paths ending in a final "#" segment at the node, as the length of its value
+func (p *Plan) resolveLengths(results []Result, value any, node *planNode, opts *Options) {
+	for _, i := range node.lengths {
+		n, err := length(value, opts)
+		if err != nil {
+			results[i].Err = lookupError(p.paths[i], err, opts)
+			continue
+		}
+
+		results[i].Value = n
+	}
+}
@@ -0,0 +1,67 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestPlan(t *testing.T) {
+	source := map[string]any{}
+	err := json.Unmarshal([]byte(`{
+		"user": {"name": "Dan", "address": {"city": "Leeds", "zip": "LS1"}},
+		"items": [{"sku": "a"}, {"sku": "b"}],
+		"counts": {"#": 5}
+	}`), &source)
+	if err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	paths := []string{
+		"user.name",
+		"user.address.city",
+		"user.address",
+		"items.1.sku",
+		"user.email",
+		"user.address.city.first",
+		"items.5.sku",
+		"user.name",
+		"items.#",
+		"user.address.#",
+		"user.name.#",
+		"#",
+		`counts.\#`,
+		"user.name|lower",
+		"user.name|upper|lower",
+		"items|round",
+		"user.missing|lower",
+	}
+
+	results := CompileSet(paths).Run(source)
+	if len(results) != len(paths) {
+		t.Fatalf("Expected: %d results but got: %d", len(paths), len(results))
+	}
+
+	for i, path := range paths {
+		if results[i].Path != path {
+			t.Errorf("Expected: %s but got: %s", path, results[i].Path)
+		}
+
+		value, err := GetErr[any](source, path)
+		if !reflect.DeepEqual(results[i].Value, value) || fmt.Sprint(results[i].Err) != fmt.Sprint(err) {
+			t.Errorf("Expected: %v (%v) but got: %v (%v)", value, err, results[i].Value, results[i].Err)
+		}
+	}
+
+	if !errors.Is(results[4].Err, ErrKeyNotFound) || !errors.Is(results[6].Err, ErrIndexOutOfBounds) {
+		t.Errorf("Unexpected errors: %v, %v", results[4].Err, results[6].Err)
+	}
+
+	r := New(map[string]any{"order": map[string]any{"M": map[string]any{"total": map[string]any{"N": "42"}}}}, WithAttributeValueUnwrap())
+	results = CompileSet([]string{"order.total", "order"}).RunReader(r)
+	if results[0].Value != int64(42) || !reflect.DeepEqual(results[1].Value, map[string]any{"total": int64(42)}) {
+		t.Errorf("Expected: 42 and map[total:42] but got: %v and %v", results[0].Value, results[1].Value)
+	}
+}
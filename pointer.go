@@ -0,0 +1,67 @@
+package mapreader
+
+import (
+	"fmt"
+	"strings"
+)
+
+// pointerUnescaper unescapes the reference tokens of a JSON Pointer
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// GetPointer returns the value referenced by a JSON Pointer (RFC 6901), ignoring any errors
+//
+// Use mapreader.GetPointerErr if you would like errors to be returned
+func GetPointer[T any](source map[string]any, pointer string) T {
+	return withoutError(GetPointerErr[T](source, pointer))
+}
+
+// GetPointerErr returns the value referenced by a JSON Pointer (RFC 6901), or returns an error
+//
+// e.g. GetPointerErr[string](source, "/a/b~1c/0") is equivalent to GetErr[string](source, `a.b/c.0`)
+// The empty pointer references the whole source. Values are converted in the same way as mapreader.GetErr.
+func GetPointerErr[T any](source map[string]any, pointer string) (T, error) {
+	return getPointer[T](source, pointer, &defaultOptions)
+}
+
+// PointerPath converts a JSON Pointer (RFC 6901) into the equivalent lookup path
+//
+// Each reference token is unescaped ("~1" to '/' and "~0" to '~') and quoted with mapreader.QuoteSegment,
+// so tokens containing characters such as '.' remain a single segment.
+// Pointers that don't start with '/', or contain an invalid escape, return ErrInvalidPath.
+func PointerPath(pointer string) (string, error) {
+	if pointer == "" {
+		return "", nil
+	}
+
+	if pointer[0] != '/' {
+		return "", fmt.Errorf("%w: JSON Pointer '%s' must start with '/'", ErrInvalidPath, pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	keys := make([]any, len(tokens))
+	for i, token := range tokens {
+		for j := 0; j < len(token); j++ {
+			if token[j] == '~' && (j+1 == len(token) || (token[j+1] != '0' && token[j+1] != '1')) {
+				return "", fmt.Errorf("%w: JSON Pointer '%s' has an invalid escape", ErrInvalidPath, pointer)
+			}
+		}
+
+		keys[i] = pointerUnescaper.Replace(token)
+	}
+
+	return PathOf(keys...), nil
+}
+
+// getPointer looks up the value referenced by a JSON Pointer and converts it into T
+func getPointer[T any](source map[string]any, pointer string, opts *Options) (T, error) {
+	path, err := PointerPath(pointer)
+	if err != nil {
+		return *new(T), err
+	}
+
+	if pointer == "" {
+		return convertValue(any(source), opts, asType[T])
+	}
+
+	return get(source, path, opts, asType[T])
+}
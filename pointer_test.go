@@ -0,0 +1,81 @@
+package mapreader
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestGetPointerErr(t *testing.T) {
+	source := map[string]any{
+		"foo":  []any{"bar", "baz"},
+		"":     0.0,
+		"a/b":  1.0,
+		"c%d":  2.0,
+		"e^f":  3.0,
+		"g|h":  4.0,
+		"i\\j": 5.0,
+		"k\"l": 6.0,
+		" ":    7.0,
+		"m~n":  8.0,
+		"a.b":  map[string]any{"*": 9.0, "#": 10.0},
+	}
+
+	tests := map[string]struct {
+		expected    any
+		expectedErr error
+	}{
+		"/foo":       {expected: []any{"bar", "baz"}},
+		"/foo/0":     {expected: "bar"},
+		"/":          {expected: 0.0},
+		"/a~1b":      {expected: 1.0},
+		"/c%d":       {expected: 2.0},
+		"/e^f":       {expected: 3.0},
+		"/g|h":       {expected: 4.0},
+		"/i\\j":      {expected: 5.0},
+		"/k\"l":      {expected: 6.0},
+		"/ ":         {expected: 7.0},
+		"/m~0n":      {expected: 8.0},
+		"/a.b/*":     {expected: 9.0},
+		"/a.b/#":     {expected: 10.0},
+		"/foo/2":     {expectedErr: ErrIndexOutOfBounds},
+		"/missing":   {expectedErr: ErrKeyNotFound},
+		"foo":        {expectedErr: ErrInvalidPath},
+		"/m~2n":      {expectedErr: ErrInvalidPath},
+		"/trailing~": {expectedErr: ErrInvalidPath},
+	}
+
+	for pointer, tc := range tests {
+		t.Run(pointer, func(t *testing.T) {
+			result, err := GetPointerErr[any](source, pointer)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+
+	if result, err := GetPointerErr[map[string]any](source, ""); err != nil || len(result) != len(source) {
+		t.Errorf("Expected the whole source but got: %v (%v)", result, err)
+	}
+}
+
+func TestPointerPath(t *testing.T) {
+	tests := map[string]string{
+		"":          "",
+		"/a/b/0":    "a.b.0",
+		"/a~1b/c.d": `a/b.c\.d`,
+		"/m~0n/~01": "m~n.~1",
+		"/":         "",
+		"/x/[id=1]": `x.\[id=1\]`,
+	}
+
+	for pointer, expected := range tests {
+		if result, err := PointerPath(pointer); err != nil || result != expected {
+			t.Errorf("Expected: %s but got: %s (%v) for %s", expected, result, err, pointer)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package mapreader
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FromProperties parses a Java style .properties or INI document into a Reader over a nested source
+//
+// See mapreader.ParseProperties for the supported syntax and the meaning of infer.
+func FromProperties(data []byte, infer func(string) any, opts ...Option) (*Reader, error) {
+	source, err := ParseProperties(data, infer)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(source, opts...), nil
+}
+
+// ParseProperties parses a Java style .properties or INI document into a nested source
+//
+// Keys are split on '.' into nested maps, so "db.host=x" can be read from the path "db.host",
+// and an escaped dot is kept within the key, so "a\.b=x" sets the key "a.b".
+// A key can't hold both a value and nested keys, so "x=1" alongside "x.y=2" is an error.
+// INI sections prefix the keys that follow them, so "host=x" within "[db]" is also read from "db.host".
+// Lines starting with '#', '!' or ';' are comments, and a trailing backslash continues a value onto the next line.
+// Values are strings, unless infer is given, in which case it's called with each value,
+// e.g. ParseProperties(data, mapreader.InferType) to read "port=80" as an int64.
+func ParseProperties(data []byte, infer func(string) any) (map[string]any, error) {
+	source := make(map[string]any)
+	var section []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		for strings.HasSuffix(line, `\`) && !strings.HasSuffix(line, `\\`) && scanner.Scan() {
+			lineNumber++
+			line = line[:len(line)-1] + strings.TrimSpace(scanner.Text())
+		}
+
+		if line == "" || strings.ContainsRune("#!;", rune(line[0])) {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = nil
+			if name := strings.TrimSpace(line[1 : len(line)-1]); name != "" {
+				var err error
+				if section, err = propertiesKey(name); err != nil {
+					return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+				}
+			}
+			continue
+		}
+
+		key, value, err := propertiesEntry(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		var v any = value
+		if infer != nil {
+			v = infer(value)
+		}
+
+		if err := setProperty(source, append(section[:len(section):len(section)], key...), v); err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return source, nil
+}
+
+// setProperty sets the value at the given key segments, creating the sections above it
+//
+// It fails if a section would replace a value, or a value would replace a section.
+func setProperty(source map[string]any, key []string, value any) error {
+	current, path := source, ""
+	for _, part := range key[:len(key)-1] {
+		path = joinPath(path, part)
+		switch next := current[part].(type) {
+		case nil:
+			child := make(map[string]any)
+			current[part] = child
+			current = child
+		case map[string]any:
+			current = next
+		default:
+			return fmt.Errorf("%w: key '%s' has a value and can't also have nested keys", ErrUnexpectedType, path)
+		}
+	}
+
+	last := key[len(key)-1]
+	if _, ok := current[last].(map[string]any); ok {
+		return fmt.Errorf("%w: key '%s' has nested keys and can't also have a value", ErrUnexpectedType, joinPath(path, last))
+	}
+
+	current[last] = value
+	return nil
+}
+
+// InferType infers the type of a properties value, returning a bool, int64 or float64 where the value parses as one
+//
+// Any other value is returned as a string.
+func InferType(s string) any {
+	if s == "true" || s == "false" {
+		return s == "true"
+	}
+
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i
+	}
+
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f
+	}
+
+	return s
+}
+
+// propertiesEntry splits a properties line into its unescaped key segments and value
+func propertiesEntry(line string) ([]string, string, error) {
+	sep := -1
+	for i := 0; i < len(line); i++ {
+		if line[i] == '\\' {
+			i++
+			continue
+		}
+
+		if line[i] == '=' || line[i] == ':' {
+			sep = i
+			break
+		}
+	}
+
+	if sep < 0 {
+		return nil, "", fmt.Errorf("%w: expected a key and value separated by '=' or ':'", ErrUnableToConvert)
+	}
+
+	key, err := propertiesKey(strings.TrimSpace(line[:sep]))
+	if err != nil {
+		return nil, "", err
+	}
+
+	value := strings.TrimSpace(line[sep+1:])
+	if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			return key, unquoted, nil
+		}
+	}
+
+	value, err = unescapeProperty(value)
+	return key, value, err
+}
+
+// propertiesKey splits a properties key on its unescaped dots, unescaping each segment
+func propertiesKey(raw string) ([]string, error) {
+	var key []string
+	start := 0
+	for i := 0; i <= len(raw); i++ {
+		if i < len(raw) && raw[i] == '\\' {
+			i++
+			continue
+		}
+
+		if i == len(raw) || raw[i] == '.' {
+			part, err := unescapeProperty(raw[start:i])
+			if err != nil {
+				return nil, err
+			}
+			key = append(key, part)
+			start = i + 1
+		}
+	}
+
+	return key, nil
+}
+
+// unescapeProperty replaces the backslash escapes of a properties key or value, including \uXXXX escapes
+func unescapeProperty(s string) (string, error) {
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+
+		i++
+		switch s[i] {
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 'f':
+			b.WriteByte('\f')
+		case 'u':
+			if i+5 > len(s) {
+				return "", fmt.Errorf("%w: invalid unicode escape in '%s'", ErrUnableToConvert, s)
+			}
+
+			r, err := strconv.ParseUint(s[i+1:i+5], 16, 32)
+			if err != nil {
+				return "", fmt.Errorf("%w: invalid unicode escape in '%s'", ErrUnableToConvert, s)
+			}
+
+			b.WriteRune(rune(r))
+			i += 4
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,97 @@
+package mapreader
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseProperties(t *testing.T) {
+	data := []byte(`# Database settings
+db.host = localhost
+db.port=5432
+! legacy comment
+db.name: orders
+greeting = café \
+    au lait
+path\=with\:separators = yes
+host\.name = example.com
+
+[cache]
+; INI comment
+enabled = true
+ratio = 0.5
+label = "quoted # value"
+`)
+
+	source, err := ParseProperties(data, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := map[string]any{
+		"db":                   map[string]any{"host": "localhost", "port": "5432", "name": "orders"},
+		"greeting":             "café au lait",
+		"path=with:separators": "yes",
+		"host.name":            "example.com",
+		"cache":                map[string]any{"enabled": "true", "ratio": "0.5", "label": "quoted # value"},
+	}
+	if !reflect.DeepEqual(source, expected) {
+		t.Errorf("Expected: %v but got: %v", expected, source)
+	}
+
+	r, err := FromProperties(data, InferType)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result, err := r.IntErr("db.port"); err != nil || result != 5432 {
+		t.Errorf("Expected: 5432 but got: %d (%v)", result, err)
+	}
+
+	if result, err := r.BoolErr("cache.enabled"); err != nil || !result {
+		t.Errorf("Expected: true but got: %t (%v)", result, err)
+	}
+
+	if result, err := r.Float64Err("cache.ratio"); err != nil || result != 0.5 {
+		t.Errorf("Expected: 0.5 but got: %v (%v)", result, err)
+	}
+
+	if result, err := r.StrErr("db.host"); err != nil || result != "localhost" {
+		t.Errorf("Expected: localhost but got: %s (%v)", result, err)
+	}
+
+	if _, err := ParseProperties([]byte("a=1\nmissing separator"), nil); !errors.Is(err, ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, err)
+	}
+
+	if result, err := r.StrErr(`host\.name`); err != nil || result != "example.com" {
+		t.Errorf("Expected: example.com but got: %s (%v)", result, err)
+	}
+
+	for _, conflict := range []string{"x=1\nx.y=2", "x.y=2\nx=1", "x=1\n[x]\ny=2"} {
+		if _, err := ParseProperties([]byte(conflict), nil); !errors.Is(err, ErrUnexpectedType) {
+			t.Errorf("Expected error: %v, but got: %v (%q)", ErrUnexpectedType, err, conflict)
+		}
+	}
+}
+
+func TestInferType(t *testing.T) {
+	tests := map[string]any{
+		"true":  true,
+		"false": false,
+		"TRUE":  "TRUE",
+		"1":     int64(1),
+		"-12":   int64(-12),
+		"1.5":   1.5,
+		"1e3":   1000.0,
+		"x":     "x",
+		"":      "",
+	}
+
+	for s, expected := range tests {
+		if result := InferType(s); result != expected {
+			t.Errorf("Expected: %#v but got: %#v (%q)", expected, result, s)
+		}
+	}
+}
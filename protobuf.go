@@ -0,0 +1,76 @@
+package mapreader
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Well-known protobuf types are recognised by their helper methods, avoiding a dependency on the protobuf module.
+type (
+	protoTimestamp interface{ AsTime() time.Time }
+	protoDuration  interface{ AsDuration() time.Duration }
+	protoStruct    interface{ AsMap() map[string]any }
+	protoList      interface{ AsSlice() []any }
+	protoValue     interface{ AsInterface() any }
+)
+
+// wellKnownValue unwraps the well-known protobuf types into their plain Go equivalents
+//
+// Timestamp becomes time.Time, Duration becomes time.Duration, Struct becomes map[string]any,
+// ListValue becomes []any, and Value becomes whichever of these (or a scalar) it holds.
+func wellKnownValue(v any) (any, bool) {
+	switch w := v.(type) {
+	case protoTimestamp:
+		return w.AsTime(), true
+	case protoDuration:
+		return w.AsDuration(), true
+	case protoStruct:
+		return w.AsMap(), true
+	case protoList:
+		return w.AsSlice(), true
+	case protoValue:
+		return w.AsInterface(), true
+	default:
+		return nil, false
+	}
+}
+
+// protobufNames returns the protobuf field name and JSON name from a generated struct field's protobuf tag
+func protobufNames(f reflect.StructField) []string {
+	tag, ok := f.Tag.Lookup("protobuf")
+	if !ok {
+		return nil
+	}
+
+	var names []string
+	for _, part := range strings.Split(tag, ",") {
+		if name, ok := strings.CutPrefix(part, "name="); ok {
+			names = append(names, name)
+		} else if name, ok := strings.CutPrefix(part, "json="); ok {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// oneofField finds a member of a generated protobuf oneof field matching the key, if it is the member that is set
+//
+// Generated code stores the set member in a wrapper struct held by an interface typed field.
+func oneofField(v reflect.Value, key string) (reflect.Value, bool) {
+	if v.Kind() != reflect.Interface || v.IsNil() {
+		return reflect.Value{}, false
+	}
+
+	wrapper := v.Elem()
+	if wrapper.Kind() == reflect.Pointer {
+		wrapper = wrapper.Elem()
+	}
+
+	if wrapper.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	return structField(wrapper, key)
+}
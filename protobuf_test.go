@@ -0,0 +1,88 @@
+package mapreader
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// The following mimic the shape of protoc-gen-go output and the well-known types
+
+type testTimestamp struct {
+	Seconds int64 `protobuf:"varint,1,opt,name=seconds,proto3" json:"seconds,omitempty"`
+}
+
+func (x *testTimestamp) AsTime() time.Time {
+	return time.Unix(x.Seconds, 0).UTC()
+}
+
+type testStruct struct {
+	fields map[string]any
+}
+
+func (x *testStruct) AsMap() map[string]any {
+	return x.fields
+}
+
+type isTestEvent_Payload interface {
+	isTestEvent_Payload()
+}
+
+type TestEvent_Text struct {
+	Text string `protobuf:"bytes,4,opt,name=text,proto3,oneof"`
+}
+
+func (*TestEvent_Text) isTestEvent_Payload() {}
+
+type testEvent struct {
+	state         struct{}
+	DisplayName   string              `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	CreateTime    *testTimestamp      `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	Attributes    *testStruct         `protobuf:"bytes,3,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Payload       isTestEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields []byte
+}
+
+func TestGetProtobufMessages(t *testing.T) {
+	source := map[string]any{
+		"event": &testEvent{
+			DisplayName: "deploy",
+			CreateTime:  &testTimestamp{Seconds: 1700000000},
+			Attributes:  &testStruct{fields: map[string]any{"region": "eu", "count": float64(2)}},
+			Payload:     &TestEvent_Text{Text: "hello"},
+		},
+	}
+
+	if result, err := StrErr(source, "event.display_name"); err != nil || result != "deploy" {
+		t.Errorf("Expected: deploy but got: %s (%v)", result, err)
+	}
+
+	if result, err := StrErr(source, "event.displayName"); err != nil || result != "deploy" {
+		t.Errorf("Expected: deploy but got: %s (%v)", result, err)
+	}
+
+	expectedTime := time.Unix(1700000000, 0).UTC()
+	if result, err := GetErr[time.Time](source, "event.create_time"); err != nil || !result.Equal(expectedTime) {
+		t.Errorf("Expected: %v but got: %v (%v)", expectedTime, result, err)
+	}
+
+	if result, err := GetErr[*testTimestamp](source, "event.create_time"); err != nil || result.Seconds != 1700000000 {
+		t.Errorf("Expected the timestamp message but got: %v (%v)", result, err)
+	}
+
+	if result, err := StrErr(source, "event.attributes.region"); err != nil || result != "eu" {
+		t.Errorf("Expected: eu but got: %s (%v)", result, err)
+	}
+
+	if result, err := IntErr(source, "event.attributes.count"); err != nil || result != 2 {
+		t.Errorf("Expected: 2 but got: %d (%v)", result, err)
+	}
+
+	if result, err := StrErr(source, "event.text"); err != nil || result != "hello" {
+		t.Errorf("Expected: hello but got: %s (%v)", result, err)
+	}
+
+	if _, err := StrErr(source, "event.state"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected error: %v, but got: %v", ErrKeyNotFound, err)
+	}
+}
@@ -0,0 +1,103 @@
+package mapreader
+
+import "errors"
+
+// GetPtr returns a pointer to the value found at the given lookup path, or nil if it's missing, null or invalid
+//
+// This maps directly onto optional fields, such as those of protobuf messages or ORM models.
+// Use mapreader.GetPtrErr if you would like conversion errors to be returned
+func GetPtr[T any](source map[string]any, path string) *T {
+	return withoutError(GetPtrErr[T](source, path))
+}
+
+// GetPtrErr returns a pointer to the value found at the given lookup path, or nil if it's missing or null
+//
+// A missing key, out of bounds index or explicit null returns nil with a nil error.
+// Values that can't be converted into T, and paths that can't be traversed, return nil with the error.
+func GetPtrErr[T any](source map[string]any, path string) (*T, error) {
+	return getPtr(source, path, &defaultOptions, asType[T])
+}
+
+// BoolPtr returns a pointer to the bool found at the given lookup path, or nil if it's missing, null or invalid
+func BoolPtr(source map[string]any, path string) *bool {
+	return GetPtr[bool](source, path)
+}
+
+// Float64Ptr returns a pointer to the float64 found at the given lookup path, or nil if it's missing, null or invalid
+func Float64Ptr(source map[string]any, path string) *float64 {
+	return NumberPtr[float64](source, path)
+}
+
+// IntPtr returns a pointer to the int found at the given lookup path, or nil if it's missing, null or invalid
+func IntPtr(source map[string]any, path string) *int {
+	return NumberPtr[int](source, path)
+}
+
+// NumberPtr returns a pointer to the number found at the given lookup path, or nil if it's missing, null or invalid
+//
+// Numbers are coerced as by mapreader.Number
+func NumberPtr[R number](source map[string]any, path string) *R {
+	return withoutError(getPtr(source, path, &defaultOptions, asNumber[R](&defaultOptions)))
+}
+
+// StrPtr returns a pointer to the string found at the given lookup path, or nil if it's missing, null or invalid
+func StrPtr(source map[string]any, path string) *string {
+	return GetPtr[string](source, path)
+}
+
+// ReadPtr is the Reader equivalent of mapreader.GetPtr
+func ReadPtr[T any](r *Reader, path string) *T {
+	return withoutError(ReadPtrErr[T](r, path))
+}
+
+// ReadPtrErr is the Reader equivalent of mapreader.GetPtrErr
+func ReadPtrErr[T any](r *Reader, path string) (*T, error) {
+	return getPtr(r.source, path, &r.opts, asType[T])
+}
+
+// ReadNumberPtr is the Reader equivalent of mapreader.NumberPtr
+func ReadNumberPtr[R number](r *Reader, path string) *R {
+	return withoutError(getPtr(r.source, path, &r.opts, asNumber[R](&r.opts)))
+}
+
+// BoolPtr is the Reader equivalent of mapreader.BoolPtr
+func (r *Reader) BoolPtr(path string) *bool {
+	return ReadPtr[bool](r, path)
+}
+
+// Float64Ptr is the Reader equivalent of mapreader.Float64Ptr
+func (r *Reader) Float64Ptr(path string) *float64 {
+	return ReadNumberPtr[float64](r, path)
+}
+
+// IntPtr is the Reader equivalent of mapreader.IntPtr
+func (r *Reader) IntPtr(path string) *int {
+	return ReadNumberPtr[int](r, path)
+}
+
+// StrPtr is the Reader equivalent of mapreader.StrPtr
+func (r *Reader) StrPtr(path string) *string {
+	return ReadPtr[string](r, path)
+}
+
+// getPtr looks up the value at the given path, converting it and returning a pointer to the result
+func getPtr[R any](source map[string]any, path string, opts *Options, convert func(any) (R, error)) (*R, error) {
+	result, err := get(source, path, opts, func(v any) (*R, error) {
+		if v == nil {
+			return nil, nil
+		}
+
+		r, err := convert(v)
+		if err != nil {
+			return nil, err
+		}
+
+		return &r, nil
+	})
+
+	if errors.Is(err, ErrKeyNotFound) || errors.Is(err, ErrIndexOutOfBounds) {
+		return nil, nil
+	}
+
+	return result, err
+}
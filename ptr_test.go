@@ -0,0 +1,74 @@
+package mapreader
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGetPtrErr(t *testing.T) {
+	source := map[string]any{"name": "jo", "null": nil, "empty": "", "count": 42.0, "list": []any{"a"}}
+
+	tests := map[string]struct {
+		expected    *string
+		expectedErr error
+	}{
+		"name":         {expected: ptrTo("jo")},
+		"empty":        {expected: ptrTo("")},
+		"list.0":       {expected: ptrTo("a")},
+		"null":         {},
+		"missing":      {},
+		"list.1":       {},
+		"count":        {expectedErr: ErrUnexpectedType},
+		"name.first.x": {expectedErr: ErrEndOfNestedStructures},
+	}
+
+	for path, tc := range tests {
+		t.Run(path, func(t *testing.T) {
+			result, err := GetPtrErr[string](source, path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if (result == nil) != (tc.expected == nil) || (result != nil && *result != *tc.expected) {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestTypedPtrs(t *testing.T) {
+	source := map[string]any{"name": "jo", "count": 42.0, "ratio": 0.5, "admin": false, "null": nil}
+
+	if result := StrPtr(source, "name"); result == nil || *result != "jo" {
+		t.Errorf("Expected: jo but got: %v", result)
+	}
+
+	if result := IntPtr(source, "count"); result == nil || *result != 42 {
+		t.Errorf("Expected: 42 but got: %v", result)
+	}
+
+	if result := Float64Ptr(source, "ratio"); result == nil || *result != 0.5 {
+		t.Errorf("Expected: 0.5 but got: %v", result)
+	}
+
+	if result := BoolPtr(source, "admin"); result == nil || *result {
+		t.Errorf("Expected: false but got: %v", result)
+	}
+
+	if IntPtr(source, "ratio") != nil || IntPtr(source, "null") != nil || StrPtr(source, "missing") != nil {
+		t.Errorf("Expected nil for invalid, null and missing values")
+	}
+
+	r := New(source)
+	if result := r.IntPtr("count"); result == nil || *result != 42 {
+		t.Errorf("Expected: 42 but got: %v", result)
+	}
+
+	if r.StrPtr("null") != nil || r.BoolPtr("name") != nil {
+		t.Errorf("Expected nil for null and invalid values")
+	}
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
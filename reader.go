@@ -0,0 +1,1116 @@
+package mapreader
+
+import (
+	"math/big"
+	"net/netip"
+	"net/url"
+	"slices"
+	"time"
+)
+
+// Reader binds a source document to a set of Options, exposing the typed getters as methods
+//
+// Go doesn't allow generic methods, so generic lookups against a Reader are available
+// through package level functions such as mapreader.ReadErr.
+type Reader struct {
+	source map[string]any
+	opts   Options
+}
+
+// New returns a Reader for the given source, configured with any given options
+//
+// Options are applied on top of the package defaults, see mapreader.SetDefaults.
+func New(source map[string]any, opts ...Option) *Reader {
+	r := &Reader{source: source, opts: defaultOptions}
+	r.opts.Unwrappers = slices.Clip(r.opts.Unwrappers)
+	r.opts.RedactPaths = slices.Clip(r.opts.RedactPaths)
+	for _, opt := range opts {
+		opt(&r.opts)
+	}
+
+	return r
+}
+
+// Source returns the underlying source document
+func (r *Reader) Source() map[string]any {
+	return r.source
+}
+
+// Read is the Reader equivalent of mapreader.Get
+func Read[T any](r *Reader, path string) T {
+	return withoutError(ReadErr[T](r, path))
+}
+
+// ReadDefault is the Reader equivalent of mapreader.GetDefault
+func ReadDefault[T any](r *Reader, path string, d T) T {
+	result, err := ReadErr[T](r, path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// ReadDefaultFunc is the Reader equivalent of mapreader.GetDefaultFunc
+func ReadDefaultFunc[T any](r *Reader, path string, d func() T) T {
+	result, err := ReadErr[T](r, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// ReadDefaultOK is the Reader equivalent of mapreader.GetDefaultOK
+func ReadDefaultOK[T any](r *Reader, path string, d T) (T, bool) {
+	result, err := ReadErr[T](r, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// ReadErr is the Reader equivalent of mapreader.GetErr
+func ReadErr[T any](r *Reader, path string) (T, error) {
+	return get(r.source, path, &r.opts, asType[T])
+}
+
+// ReadOK is the Reader equivalent of mapreader.GetOK
+func ReadOK[T any](r *Reader, path string) (T, bool) {
+	result, err := ReadErr[T](r, path)
+	if err != nil {
+		return *new(T), false
+	}
+
+	return result, true
+}
+
+// ReadAll is the Reader equivalent of mapreader.GetAll
+func ReadAll[T any](r *Reader, path string) []T {
+	return withoutError(ReadAllErr[T](r, path))
+}
+
+// ReadAllErr is the Reader equivalent of mapreader.GetAllErr
+func ReadAllErr[T any](r *Reader, path string) ([]T, error) {
+	return getAll[T](r.source, path, &r.opts)
+}
+
+// ReadColumn is the Reader equivalent of mapreader.Column
+func ReadColumn[T any](r *Reader, arrayPath, key string) ([]T, error) {
+	return column[T](r.source, arrayPath, key, &r.opts)
+}
+
+// ReadDecode is the Reader equivalent of mapreader.Decode
+func ReadDecode[T any](r *Reader, path string) T {
+	return withoutError(ReadDecodeErr[T](r, path))
+}
+
+// ReadDecodeErr is the Reader equivalent of mapreader.DecodeErr
+func ReadDecodeErr[T any](r *Reader, path string) (T, error) {
+	return get(r.source, path, &r.opts, asDecodedType[T])
+}
+
+// ReadFirst is the Reader equivalent of mapreader.First
+func ReadFirst[T any](r *Reader, paths ...string) T {
+	return withoutError(ReadFirstErr[T](r, paths...))
+}
+
+// ReadFirstErr is the Reader equivalent of mapreader.FirstErr
+func ReadFirstErr[T any](r *Reader, paths ...string) (T, error) {
+	return getFirst[T](r.source, paths, &r.opts)
+}
+
+// ReadInto is the Reader equivalent of mapreader.GetInto
+func ReadInto[T any](r *Reader, path string, dst *T) error {
+	return getInto(r.source, path, &r.opts, dst)
+}
+
+// ReadMany is the Reader equivalent of mapreader.GetMany
+func ReadMany[T any](r *Reader, paths ...string) ([]T, error) {
+	return getMany[T](r.source, paths, &r.opts)
+}
+
+// ReadManyPartial is the Reader equivalent of mapreader.GetManyPartial
+func ReadManyPartial[T any](r *Reader, paths ...string) ([]T, error) {
+	return getManyPartial[T](r.source, paths, &r.opts)
+}
+
+// ReadNullable is the Reader equivalent of mapreader.GetNullable
+func ReadNullable[T any](r *Reader, path string) (value T, present bool, isNull bool, err error) {
+	return getNullable[T](r.source, path, &r.opts)
+}
+
+// ReadPointer is the Reader equivalent of mapreader.GetPointer
+func ReadPointer[T any](r *Reader, pointer string) T {
+	return withoutError(ReadPointerErr[T](r, pointer))
+}
+
+// ReadPointerErr is the Reader equivalent of mapreader.GetPointerErr
+func ReadPointerErr[T any](r *Reader, pointer string) (T, error) {
+	return getPointer[T](r.source, pointer, &r.opts)
+}
+
+// ReadQuery is the Reader equivalent of mapreader.Query
+func ReadQuery[T any](r *Reader, expr string) []T {
+	return withoutError(ReadQueryErr[T](r, expr))
+}
+
+// ReadQueryErr is the Reader equivalent of mapreader.QueryErr
+func ReadQueryErr[T any](r *Reader, expr string) ([]T, error) {
+	return query[T](r.source, expr, &r.opts)
+}
+
+// ReadSearch is the Reader equivalent of mapreader.Search
+func ReadSearch[T any](r *Reader, expr JMESPathExpression) T {
+	return withoutError(ReadSearchErr[T](r, expr))
+}
+
+// ReadSearchErr is the Reader equivalent of mapreader.SearchErr
+func ReadSearchErr[T any](r *Reader, expr JMESPathExpression) (T, error) {
+	return search[T](r.source, expr, &r.opts)
+}
+
+// ReadMap is the Reader equivalent of mapreader.Map
+func ReadMap[V any](r *Reader, path string) map[string]V {
+	return withoutError(ReadMapErr[V](r, path))
+}
+
+// ReadMapDefault is the Reader equivalent of mapreader.MapDefault
+func ReadMapDefault[V any](r *Reader, path string, d map[string]V) map[string]V {
+	result, err := ReadMapErr[V](r, path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// ReadMapDefaultFunc is the Reader equivalent of mapreader.MapDefaultFunc
+func ReadMapDefaultFunc[V any](r *Reader, path string, d func() map[string]V) map[string]V {
+	result, err := ReadMapErr[V](r, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// ReadMapDefaultOK is the Reader equivalent of mapreader.MapDefaultOK
+func ReadMapDefaultOK[V any](r *Reader, path string, d map[string]V) (map[string]V, bool) {
+	result, err := ReadMapErr[V](r, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// ReadMapErr is the Reader equivalent of mapreader.MapErr
+func ReadMapErr[V any](r *Reader, path string) (map[string]V, error) {
+	return get(r.source, path, &r.opts, asMapType[V])
+}
+
+// ReadMapInto is the Reader equivalent of mapreader.MapInto
+func ReadMapInto[V any](r *Reader, path string, dst map[string]V) error {
+	return mapInto(r.source, path, &r.opts, dst)
+}
+
+// ReadNumber is the Reader equivalent of mapreader.Number
+func ReadNumber[R number](r *Reader, path string) R {
+	return withoutError(ReadNumberErr[R](r, path))
+}
+
+// ReadNumberDefault is the Reader equivalent of mapreader.NumberDefault
+func ReadNumberDefault[R number](r *Reader, path string, d R) R {
+	result, err := ReadNumberErr[R](r, path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// ReadNumberDefaultFunc is the Reader equivalent of mapreader.NumberDefaultFunc
+func ReadNumberDefaultFunc[R number](r *Reader, path string, d func() R) R {
+	result, err := ReadNumberErr[R](r, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// ReadNumberDefaultOK is the Reader equivalent of mapreader.NumberDefaultOK
+func ReadNumberDefaultOK[R number](r *Reader, path string, d R) (R, bool) {
+	result, err := ReadNumberErr[R](r, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// ReadNumberErr is the Reader equivalent of mapreader.NumberErr
+func ReadNumberErr[R number](r *Reader, path string) (R, error) {
+	return get(r.source, path, &r.opts, asNumber[R](&r.opts))
+}
+
+// ReadNumberSlice is the Reader equivalent of mapreader.NumberSlice
+func ReadNumberSlice[R number](r *Reader, path string) []R {
+	return withoutError(ReadNumberSliceErr[R](r, path))
+}
+
+// ReadNumberSliceDefault is the Reader equivalent of mapreader.NumberSliceDefault
+func ReadNumberSliceDefault[R number](r *Reader, path string, d []R) []R {
+	result, err := ReadNumberSliceErr[R](r, path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// ReadNumberSliceDefaultFunc is the Reader equivalent of mapreader.NumberSliceDefaultFunc
+func ReadNumberSliceDefaultFunc[R number](r *Reader, path string, d func() []R) []R {
+	result, err := ReadNumberSliceErr[R](r, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// ReadNumberSliceDefaultOK is the Reader equivalent of mapreader.NumberSliceDefaultOK
+func ReadNumberSliceDefaultOK[R number](r *Reader, path string, d []R) ([]R, bool) {
+	result, err := ReadNumberSliceErr[R](r, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// ReadNumberSliceErr is the Reader equivalent of mapreader.NumberSliceErr
+func ReadNumberSliceErr[R number](r *Reader, path string) ([]R, error) {
+	return get(r.source, path, &r.opts, asNumberSlice[R](&r.opts))
+}
+
+// ReadSlice is the Reader equivalent of mapreader.Slice
+func ReadSlice[V any](r *Reader, path string) []V {
+	return withoutError(ReadSliceErr[V](r, path))
+}
+
+// ReadSliceDefault is the Reader equivalent of mapreader.SliceDefault
+func ReadSliceDefault[V any](r *Reader, path string, d []V) []V {
+	result, err := ReadSliceErr[V](r, path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// ReadSliceDefaultFunc is the Reader equivalent of mapreader.SliceDefaultFunc
+func ReadSliceDefaultFunc[V any](r *Reader, path string, d func() []V) []V {
+	result, err := ReadSliceErr[V](r, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// ReadSliceDefaultOK is the Reader equivalent of mapreader.SliceDefaultOK
+func ReadSliceDefaultOK[V any](r *Reader, path string, d []V) ([]V, bool) {
+	result, err := ReadSliceErr[V](r, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// ReadSliceErr is the Reader equivalent of mapreader.SliceErr
+func ReadSliceErr[V any](r *Reader, path string) ([]V, error) {
+	return get(r.source, path, &r.opts, asSliceType[V])
+}
+
+// ReadSliceInto is the Reader equivalent of mapreader.SliceInto
+func ReadSliceInto[V any](r *Reader, path string, dst []V) ([]V, error) {
+	return sliceInto(r.source, path, &r.opts, dst)
+}
+
+// Addr is the Reader equivalent of mapreader.Addr
+func (r *Reader) Addr(path string) netip.Addr {
+	return withoutError(r.AddrErr(path))
+}
+
+// AddrDefault is the Reader equivalent of mapreader.AddrDefault
+func (r *Reader) AddrDefault(path string, d netip.Addr) netip.Addr {
+	result, err := r.AddrErr(path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// AddrDefaultFunc is the Reader equivalent of mapreader.AddrDefaultFunc
+func (r *Reader) AddrDefaultFunc(path string, d func() netip.Addr) netip.Addr {
+	result, err := r.AddrErr(path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// AddrDefaultOK is the Reader equivalent of mapreader.AddrDefaultOK
+func (r *Reader) AddrDefaultOK(path string, d netip.Addr) (netip.Addr, bool) {
+	result, err := r.AddrErr(path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// AddrErr is the Reader equivalent of mapreader.AddrErr
+func (r *Reader) AddrErr(path string) (netip.Addr, error) {
+	return get(r.source, path, &r.opts, asAddr)
+}
+
+// BigFloat is the Reader equivalent of mapreader.BigFloat
+func (r *Reader) BigFloat(path string) *big.Float {
+	return withoutError(r.BigFloatErr(path))
+}
+
+// BigFloatDefault is the Reader equivalent of mapreader.BigFloatDefault
+func (r *Reader) BigFloatDefault(path string, d *big.Float) *big.Float {
+	result, err := r.BigFloatErr(path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// BigFloatDefaultFunc is the Reader equivalent of mapreader.BigFloatDefaultFunc
+func (r *Reader) BigFloatDefaultFunc(path string, d func() *big.Float) *big.Float {
+	result, err := r.BigFloatErr(path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// BigFloatDefaultOK is the Reader equivalent of mapreader.BigFloatDefaultOK
+func (r *Reader) BigFloatDefaultOK(path string, d *big.Float) (*big.Float, bool) {
+	result, err := r.BigFloatErr(path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// BigFloatErr is the Reader equivalent of mapreader.BigFloatErr
+func (r *Reader) BigFloatErr(path string) (*big.Float, error) {
+	return get(r.source, path, &r.opts, asBigFloat)
+}
+
+// BigInt is the Reader equivalent of mapreader.BigInt
+func (r *Reader) BigInt(path string) *big.Int {
+	return withoutError(r.BigIntErr(path))
+}
+
+// BigIntDefault is the Reader equivalent of mapreader.BigIntDefault
+func (r *Reader) BigIntDefault(path string, d *big.Int) *big.Int {
+	result, err := r.BigIntErr(path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// BigIntDefaultFunc is the Reader equivalent of mapreader.BigIntDefaultFunc
+func (r *Reader) BigIntDefaultFunc(path string, d func() *big.Int) *big.Int {
+	result, err := r.BigIntErr(path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// BigIntDefaultOK is the Reader equivalent of mapreader.BigIntDefaultOK
+func (r *Reader) BigIntDefaultOK(path string, d *big.Int) (*big.Int, bool) {
+	result, err := r.BigIntErr(path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// BigIntErr is the Reader equivalent of mapreader.BigIntErr
+func (r *Reader) BigIntErr(path string) (*big.Int, error) {
+	return get(r.source, path, &r.opts, asBigInt)
+}
+
+// Bind is the Reader equivalent of mapreader.Bind
+func (r *Reader) Bind(dst any) error {
+	return bind(r.source, dst, &r.opts)
+}
+
+// Bool is the Reader equivalent of mapreader.Bool
+func (r *Reader) Bool(path string) bool {
+	return withoutError(r.BoolErr(path))
+}
+
+// BoolDefault is the Reader equivalent of mapreader.BoolDefault
+func (r *Reader) BoolDefault(path string, d bool) bool {
+	result, err := r.BoolErr(path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// BoolDefaultFunc is the Reader equivalent of mapreader.BoolDefaultFunc
+func (r *Reader) BoolDefaultFunc(path string, d func() bool) bool {
+	result, err := r.BoolErr(path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// BoolDefaultOK is the Reader equivalent of mapreader.BoolDefaultOK
+func (r *Reader) BoolDefaultOK(path string, d bool) (bool, bool) {
+	result, err := r.BoolErr(path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// BoolErr is the Reader equivalent of mapreader.BoolErr
+func (r *Reader) BoolErr(path string) (bool, error) {
+	return get(r.source, path, &r.opts, asType[bool])
+}
+
+// BoolLenient is the Reader equivalent of mapreader.BoolLenient
+func (r *Reader) BoolLenient(path string) bool {
+	return withoutError(r.BoolLenientErr(path))
+}
+
+// BoolLenientDefault is the Reader equivalent of mapreader.BoolLenientDefault
+func (r *Reader) BoolLenientDefault(path string, d bool) bool {
+	result, err := r.BoolLenientErr(path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// BoolLenientDefaultFunc is the Reader equivalent of mapreader.BoolLenientDefaultFunc
+func (r *Reader) BoolLenientDefaultFunc(path string, d func() bool) bool {
+	result, err := r.BoolLenientErr(path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// BoolLenientDefaultOK is the Reader equivalent of mapreader.BoolLenientDefaultOK
+func (r *Reader) BoolLenientDefaultOK(path string, d bool) (bool, bool) {
+	result, err := r.BoolLenientErr(path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// BoolLenientErr is the Reader equivalent of mapreader.BoolLenientErr
+func (r *Reader) BoolLenientErr(path string) (bool, error) {
+	return get(r.source, path, &r.opts, asBoolLenient)
+}
+
+// ByKey is the Reader equivalent of mapreader.ByKey
+func (r *Reader) ByKey(arrayPath, field string, value any) (map[string]any, error) {
+	return byKey(r.source, arrayPath, field, value, &r.opts)
+}
+
+// Bytes is the Reader equivalent of mapreader.Bytes
+func (r *Reader) Bytes(path string) []byte {
+	return withoutError(r.BytesErr(path))
+}
+
+// BytesDefault is the Reader equivalent of mapreader.BytesDefault
+func (r *Reader) BytesDefault(path string, d []byte) []byte {
+	result, err := r.BytesErr(path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// BytesDefaultFunc is the Reader equivalent of mapreader.BytesDefaultFunc
+func (r *Reader) BytesDefaultFunc(path string, d func() []byte) []byte {
+	result, err := r.BytesErr(path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// BytesDefaultOK is the Reader equivalent of mapreader.BytesDefaultOK
+func (r *Reader) BytesDefaultOK(path string, d []byte) ([]byte, bool) {
+	result, err := r.BytesErr(path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// BytesErr is the Reader equivalent of mapreader.BytesErr
+func (r *Reader) BytesErr(path string) ([]byte, error) {
+	return get(r.source, path, &r.opts, asBytes)
+}
+
+// BytesBase64 is the Reader equivalent of mapreader.BytesBase64
+func (r *Reader) BytesBase64(path string) []byte {
+	return withoutError(r.BytesBase64Err(path))
+}
+
+// BytesBase64Default is the Reader equivalent of mapreader.BytesBase64Default
+func (r *Reader) BytesBase64Default(path string, d []byte) []byte {
+	result, err := r.BytesBase64Err(path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// BytesBase64DefaultFunc is the Reader equivalent of mapreader.BytesBase64DefaultFunc
+func (r *Reader) BytesBase64DefaultFunc(path string, d func() []byte) []byte {
+	result, err := r.BytesBase64Err(path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// BytesBase64DefaultOK is the Reader equivalent of mapreader.BytesBase64DefaultOK
+func (r *Reader) BytesBase64DefaultOK(path string, d []byte) ([]byte, bool) {
+	result, err := r.BytesBase64Err(path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// BytesBase64Err is the Reader equivalent of mapreader.BytesBase64Err
+func (r *Reader) BytesBase64Err(path string) ([]byte, error) {
+	return get(r.source, path, &r.opts, asBase64Bytes)
+}
+
+// BytesStrict is the Reader equivalent of mapreader.BytesStrict
+func (r *Reader) BytesStrict(path string) []byte {
+	return withoutError(r.BytesStrictErr(path))
+}
+
+// BytesStrictDefault is the Reader equivalent of mapreader.BytesStrictDefault
+func (r *Reader) BytesStrictDefault(path string, d []byte) []byte {
+	result, err := r.BytesStrictErr(path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// BytesStrictDefaultFunc is the Reader equivalent of mapreader.BytesStrictDefaultFunc
+func (r *Reader) BytesStrictDefaultFunc(path string, d func() []byte) []byte {
+	result, err := r.BytesStrictErr(path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// BytesStrictDefaultOK is the Reader equivalent of mapreader.BytesStrictDefaultOK
+func (r *Reader) BytesStrictDefaultOK(path string, d []byte) ([]byte, bool) {
+	result, err := r.BytesStrictErr(path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// BytesStrictErr is the Reader equivalent of mapreader.BytesStrictErr
+func (r *Reader) BytesStrictErr(path string) ([]byte, error) {
+	return get(r.source, path, &r.opts, asType[[]byte])
+}
+
+// Duration is the Reader equivalent of mapreader.Duration
+func (r *Reader) Duration(path string) time.Duration {
+	return withoutError(r.DurationErr(path))
+}
+
+// DurationDefault is the Reader equivalent of mapreader.DurationDefault
+func (r *Reader) DurationDefault(path string, d time.Duration) time.Duration {
+	result, err := r.DurationErr(path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// DurationDefaultFunc is the Reader equivalent of mapreader.DurationDefaultFunc
+func (r *Reader) DurationDefaultFunc(path string, d func() time.Duration) time.Duration {
+	result, err := r.DurationErr(path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// DurationDefaultOK is the Reader equivalent of mapreader.DurationDefaultOK
+func (r *Reader) DurationDefaultOK(path string, d time.Duration) (time.Duration, bool) {
+	result, err := r.DurationErr(path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// DurationErr is the Reader equivalent of mapreader.DurationErr
+func (r *Reader) DurationErr(path string) (time.Duration, error) {
+	return get(r.source, path, &r.opts, asDuration(r.opts.DurationUnit))
+}
+
+// Float64 is the Reader equivalent of mapreader.Float64
+func (r *Reader) Float64(path string) float64 {
+	return withoutError(r.Float64Err(path))
+}
+
+// Float64Default is the Reader equivalent of mapreader.Float64Default
+func (r *Reader) Float64Default(path string, d float64) float64 {
+	result, err := r.Float64Err(path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// Float64DefaultFunc is the Reader equivalent of mapreader.Float64DefaultFunc
+func (r *Reader) Float64DefaultFunc(path string, d func() float64) float64 {
+	result, err := r.Float64Err(path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// Float64DefaultOK is the Reader equivalent of mapreader.Float64DefaultOK
+func (r *Reader) Float64DefaultOK(path string, d float64) (float64, bool) {
+	result, err := r.Float64Err(path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// Float64Err is the Reader equivalent of mapreader.Float64Err
+func (r *Reader) Float64Err(path string) (float64, error) {
+	return get(r.source, path, &r.opts, asNumber[float64](&r.opts))
+}
+
+// Float64Flexible is the Reader equivalent of mapreader.Float64Flexible
+func (r *Reader) Float64Flexible(path string) (float64, error) {
+	return get(r.source, path, &r.opts, asFlexibleNumber[float64])
+}
+
+// ForEach is the Reader equivalent of mapreader.ForEach
+func (r *Reader) ForEach(path string, fn func(key string, value any)) {
+	_ = r.ForEachErr(path, func(key string, value any) error {
+		fn(key, value)
+		return nil
+	})
+}
+
+// ForEachErr is the Reader equivalent of mapreader.ForEachErr
+func (r *Reader) ForEachErr(path string, fn func(key string, value any) error) error {
+	return forEach(r.source, path, &r.opts, fn)
+}
+
+// Has is the Reader equivalent of mapreader.Has
+func (r *Reader) Has(path string) bool {
+	ok, _ := r.HasErr(path)
+	return ok
+}
+
+// HasErr is the Reader equivalent of mapreader.HasErr
+func (r *Reader) HasErr(path string) (bool, error) {
+	return has(r.source, path, &r.opts)
+}
+
+// IndexBy is the Reader equivalent of mapreader.IndexBy
+func (r *Reader) IndexBy(arrayPath, field string) (map[string]any, error) {
+	return indexBy(r.source, arrayPath, field, &r.opts)
+}
+
+// IsNull is the Reader equivalent of mapreader.IsNull
+func (r *Reader) IsNull(path string) bool {
+	return isNull(r.source, path, &r.opts)
+}
+
+// Int is the Reader equivalent of mapreader.Int
+func (r *Reader) Int(path string) int {
+	return withoutError(r.IntErr(path))
+}
+
+// IntDefault is the Reader equivalent of mapreader.IntDefault
+func (r *Reader) IntDefault(path string, d int) int {
+	result, err := r.IntErr(path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// IntDefaultFunc is the Reader equivalent of mapreader.IntDefaultFunc
+func (r *Reader) IntDefaultFunc(path string, d func() int) int {
+	result, err := r.IntErr(path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// IntDefaultOK is the Reader equivalent of mapreader.IntDefaultOK
+func (r *Reader) IntDefaultOK(path string, d int) (int, bool) {
+	result, err := r.IntErr(path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// IntErr is the Reader equivalent of mapreader.IntErr
+func (r *Reader) IntErr(path string) (int, error) {
+	return get(r.source, path, &r.opts, asNumber[int](&r.opts))
+}
+
+// IntFlexible is the Reader equivalent of mapreader.IntFlexible
+func (r *Reader) IntFlexible(path string) (int, error) {
+	return get(r.source, path, &r.opts, asFlexibleNumber[int])
+}
+
+// Keys is the Reader equivalent of mapreader.Keys
+func (r *Reader) Keys(path string) []string {
+	return withoutError(r.KeysErr(path))
+}
+
+// KeysErr is the Reader equivalent of mapreader.KeysErr
+func (r *Reader) KeysErr(path string) ([]string, error) {
+	return get(r.source, path, &r.opts, asKeys)
+}
+
+// Kind is the Reader equivalent of mapreader.Kind
+func (r *Reader) Kind(path string) ValueKind {
+	return withoutError(r.KindErr(path))
+}
+
+// KindErr is the Reader equivalent of mapreader.KindErr
+func (r *Reader) KindErr(path string) (ValueKind, error) {
+	return kindOf(r.source, path, &r.opts)
+}
+
+// Len is the Reader equivalent of mapreader.Len
+func (r *Reader) Len(path string) int {
+	return withoutError(r.LenErr(path))
+}
+
+// LenErr is the Reader equivalent of mapreader.LenErr
+func (r *Reader) LenErr(path string) (int, error) {
+	return get(r.source, path, &r.opts, asLen)
+}
+
+// Prefix is the Reader equivalent of mapreader.Prefix
+func (r *Reader) Prefix(path string) netip.Prefix {
+	return withoutError(r.PrefixErr(path))
+}
+
+// PrefixDefault is the Reader equivalent of mapreader.PrefixDefault
+func (r *Reader) PrefixDefault(path string, d netip.Prefix) netip.Prefix {
+	result, err := r.PrefixErr(path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// PrefixDefaultFunc is the Reader equivalent of mapreader.PrefixDefaultFunc
+func (r *Reader) PrefixDefaultFunc(path string, d func() netip.Prefix) netip.Prefix {
+	result, err := r.PrefixErr(path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// PrefixDefaultOK is the Reader equivalent of mapreader.PrefixDefaultOK
+func (r *Reader) PrefixDefaultOK(path string, d netip.Prefix) (netip.Prefix, bool) {
+	result, err := r.PrefixErr(path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// PrefixErr is the Reader equivalent of mapreader.PrefixErr
+func (r *Reader) PrefixErr(path string) (netip.Prefix, error) {
+	return get(r.source, path, &r.opts, asPrefix)
+}
+
+// Scan is the Reader equivalent of mapreader.Scan
+func (r *Reader) Scan(pairs ...any) error {
+	return scan(r.source, &r.opts, pairs)
+}
+
+// Str is the Reader equivalent of mapreader.Str
+func (r *Reader) Str(path string) string {
+	return withoutError(r.StrErr(path))
+}
+
+// StrDefault is the Reader equivalent of mapreader.StrDefault
+func (r *Reader) StrDefault(path string, d string) string {
+	result, err := r.StrErr(path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// StrDefaultFunc is the Reader equivalent of mapreader.StrDefaultFunc
+func (r *Reader) StrDefaultFunc(path string, d func() string) string {
+	result, err := r.StrErr(path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// StrDefaultOK is the Reader equivalent of mapreader.StrDefaultOK
+func (r *Reader) StrDefaultOK(path string, d string) (string, bool) {
+	result, err := r.StrErr(path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// StrErr is the Reader equivalent of mapreader.StrErr
+func (r *Reader) StrErr(path string) (string, error) {
+	return get(r.source, path, &r.opts, asType[string])
+}
+
+// StrLenient is the Reader equivalent of mapreader.StrLenient
+func (r *Reader) StrLenient(path string) string {
+	return withoutError(r.StrLenientErr(path))
+}
+
+// StrLenientDefault is the Reader equivalent of mapreader.StrLenientDefault
+func (r *Reader) StrLenientDefault(path string, d string) string {
+	result, err := r.StrLenientErr(path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// StrLenientDefaultFunc is the Reader equivalent of mapreader.StrLenientDefaultFunc
+func (r *Reader) StrLenientDefaultFunc(path string, d func() string) string {
+	result, err := r.StrLenientErr(path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// StrLenientDefaultOK is the Reader equivalent of mapreader.StrLenientDefaultOK
+func (r *Reader) StrLenientDefaultOK(path string, d string) (string, bool) {
+	result, err := r.StrLenientErr(path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// StrLenientErr is the Reader equivalent of mapreader.StrLenientErr
+func (r *Reader) StrLenientErr(path string) (string, error) {
+	return get(r.source, path, &r.opts, asStrLenient)
+}
+
+// StrCoerce is the Reader equivalent of mapreader.StrCoerce
+func (r *Reader) StrCoerce(path string) string {
+	return withoutError(r.StrCoerceErr(path))
+}
+
+// StrCoerceDefault is the Reader equivalent of mapreader.StrCoerceDefault
+func (r *Reader) StrCoerceDefault(path string, d string) string {
+	result, err := r.StrCoerceErr(path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// StrCoerceDefaultFunc is the Reader equivalent of mapreader.StrCoerceDefaultFunc
+func (r *Reader) StrCoerceDefaultFunc(path string, d func() string) string {
+	result, err := r.StrCoerceErr(path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// StrCoerceDefaultOK is the Reader equivalent of mapreader.StrCoerceDefaultOK
+func (r *Reader) StrCoerceDefaultOK(path string, d string) (string, bool) {
+	result, err := r.StrCoerceErr(path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// StrCoerceErr is the Reader equivalent of mapreader.StrCoerceErr
+func (r *Reader) StrCoerceErr(path string) (string, error) {
+	return get(r.source, path, &r.opts, asStrCoerce)
+}
+
+// StrSliceFlexible is the Reader equivalent of mapreader.StrSliceFlexible
+func (r *Reader) StrSliceFlexible(path string) ([]string, error) {
+	return get(r.source, path, &r.opts, asStrOrStrSlice)
+}
+
+// URL is the Reader equivalent of mapreader.URL
+func (r *Reader) URL(path string) *url.URL {
+	return withoutError(r.URLErr(path))
+}
+
+// URLDefault is the Reader equivalent of mapreader.URLDefault
+func (r *Reader) URLDefault(path string, d *url.URL) *url.URL {
+	result, err := r.URLErr(path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// URLDefaultFunc is the Reader equivalent of mapreader.URLDefaultFunc
+func (r *Reader) URLDefaultFunc(path string, d func() *url.URL) *url.URL {
+	result, err := r.URLErr(path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// URLDefaultOK is the Reader equivalent of mapreader.URLDefaultOK
+func (r *Reader) URLDefaultOK(path string, d *url.URL) (*url.URL, bool) {
+	result, err := r.URLErr(path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// URLErr is the Reader equivalent of mapreader.URLErr
+func (r *Reader) URLErr(path string) (*url.URL, error) {
+	return get(r.source, path, &r.opts, asURL)
+}
+
+// UUID is the Reader equivalent of mapreader.UUID
+func (r *Reader) UUID(path string) [16]byte {
+	return withoutError(r.UUIDErr(path))
+}
+
+// UUIDDefault is the Reader equivalent of mapreader.UUIDDefault
+func (r *Reader) UUIDDefault(path string, d [16]byte) [16]byte {
+	result, err := r.UUIDErr(path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// UUIDDefaultFunc is the Reader equivalent of mapreader.UUIDDefaultFunc
+func (r *Reader) UUIDDefaultFunc(path string, d func() [16]byte) [16]byte {
+	result, err := r.UUIDErr(path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// UUIDDefaultOK is the Reader equivalent of mapreader.UUIDDefaultOK
+func (r *Reader) UUIDDefaultOK(path string, d [16]byte) ([16]byte, bool) {
+	result, err := r.UUIDErr(path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// UUIDErr is the Reader equivalent of mapreader.UUIDErr
+func (r *Reader) UUIDErr(path string) ([16]byte, error) {
+	return get(r.source, path, &r.opts, asUUID(r.opts.UUIDParser))
+}
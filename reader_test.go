@@ -0,0 +1,319 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestReader(t *testing.T) {
+	source := map[string]any{}
+	if err := json.Unmarshal([]byte(`{"a": {"b": "value", "c": 1, "d": true}}`), &source); err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	r := New(source)
+
+	if result, err := r.StrErr("a.b"); err != nil || result != "value" {
+		t.Errorf("Expected: value but got: %s (%v)", result, err)
+	}
+
+	if result := r.Int("a.c"); result != 1 {
+		t.Errorf("Expected: 1 but got: %d", result)
+	}
+
+	if result := r.BoolDefault("a.missing", true); !result {
+		t.Error("Default should be used when lookup fails")
+	}
+
+	if result, err := ReadErr[map[string]any](r, "a"); err != nil || len(result) != 3 {
+		t.Errorf("Expected the nested map but got: %v (%v)", result, err)
+	}
+
+	if _, err := r.Float64Err("a.b"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+}
+
+func TestReaderOptionsApplyToAllGetters(t *testing.T) {
+	source := map[string]any{}
+	err := json.Unmarshal([]byte(`{
+		"config": [{
+			"name": "web",
+			"ports": [80, 443],
+			"labels": {"app": "web"},
+			"items": [{"id": "a"}, {"id": "b"}]
+		}]
+	}`), &source)
+	if err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	r := New(source, WithSingletonListUnwrap())
+
+	if result, err := ReadSliceErr[any](r, "config.ports"); err != nil || len(result) != 2 {
+		t.Errorf("Expected: [80 443] but got: %v (%v)", result, err)
+	}
+
+	if result, err := ReadNumberSliceErr[int](r, "config.ports"); err != nil || result[1] != 443 {
+		t.Errorf("Expected: [80 443] but got: %v (%v)", result, err)
+	}
+
+	if result, err := ReadMapErr[string](r, "config.labels"); err != nil || result["app"] != "web" {
+		t.Errorf("Expected: map[app:web] but got: %v (%v)", result, err)
+	}
+
+	if result, err := ReadNumberErr[uint16](r, "config.ports.0"); err != nil || result != 80 {
+		t.Errorf("Expected: 80 but got: %d (%v)", result, err)
+	}
+
+	if result, err := ReadColumn[string](r, "config.items", "id"); err != nil || len(result) != 2 || result[1] != "b" {
+		t.Errorf("Expected: [a b] but got: %v (%v)", result, err)
+	}
+
+	if result, err := ReadMany[string](r, "config.name", "config.labels.app"); err != nil || result[1] != "web" {
+		t.Errorf("Expected: [web web] but got: %v (%v)", result, err)
+	}
+
+	if result, present, isNull, err := ReadNullable[string](r, "config.name"); err != nil || !present || isNull || result != "web" {
+		t.Errorf("Expected: (web, true, false) but got: (%s, %t, %t, %v)", result, present, isNull, err)
+	}
+
+	if result, usedDefault := r.StrDefaultOK("config.missing", "default"); result != "default" || !usedDefault {
+		t.Errorf("Expected: (default, true) but got: (%s, %t)", result, usedDefault)
+	}
+
+	var name string
+	var port int
+	if err := r.Scan("config.name", &name, "config.ports.1", &port); err != nil || name != "web" || port != 443 {
+		t.Errorf("Expected: (web, 443) but got: (%s, %d, %v)", name, port, err)
+	}
+
+	if _, err := SliceErr[any](source, "config.ports"); !errors.Is(err, ErrNonIntegerSliceAccess) {
+		t.Errorf("Expected error: %v, but got: %v", ErrNonIntegerSliceAccess, err)
+	}
+}
+
+func TestReaderSingletonListUnwrap(t *testing.T) {
+	source := map[string]any{}
+	err := json.Unmarshal([]byte(`{
+		"resource": {
+			"aws_instance": {
+				"web": [{"ami": "ami-123", "tags": [{"Name": "web"}]}]
+			}
+		},
+		"variable": [{"region": {"default": "eu-west-1"}}, {"zone": {"default": "a"}}]
+	}`), &source)
+	if err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	r := New(source, WithSingletonListUnwrap())
+
+	if result, err := r.StrErr("resource.aws_instance.web.ami"); err != nil || result != "ami-123" {
+		t.Errorf("Expected: ami-123 but got: %s (%v)", result, err)
+	}
+
+	if result, err := r.StrErr("resource.aws_instance.web.tags.Name"); err != nil || result != "web" {
+		t.Errorf("Expected: web but got: %s (%v)", result, err)
+	}
+
+	if result, err := r.StrErr("resource.aws_instance.web.0.ami"); err != nil || result != "ami-123" {
+		t.Errorf("Explicit indices should still work, expected: ami-123 but got: %s (%v)", result, err)
+	}
+
+	if result, err := r.StrErr("variable.region.default"); err != nil || result != "eu-west-1" {
+		t.Errorf("Expected: eu-west-1 but got: %s (%v)", result, err)
+	}
+
+	if result, err := r.StrErr("variable.zone.default"); err != nil || result != "a" {
+		t.Errorf("Expected: a but got: %s (%v)", result, err)
+	}
+
+	if result, err := r.StrErr("variable.1.zone.default"); err != nil || result != "a" {
+		t.Errorf("Explicit indices should still work, expected: a but got: %s (%v)", result, err)
+	}
+
+	if _, err := r.StrErr("variable.missing.default"); !errors.Is(err, ErrNonIntegerSliceAccess) {
+		t.Errorf("Expected error: %v, but got: %v", ErrNonIntegerSliceAccess, err)
+	}
+
+	if _, err := StrErr(source, "resource.aws_instance.web.ami"); !errors.Is(err, ErrNonIntegerSliceAccess) {
+		t.Errorf("Package level lookups shouldn't unwrap lists, but got: %v", err)
+	}
+}
+
+func TestReaderUnionUnwrap(t *testing.T) {
+	source := map[string]any{
+		"user": map[string]any{
+			"email":    map[string]any{"string": "jo@example.com"},
+			"age":      map[string]any{"int": int32(30)},
+			"nickname": nil,
+			"address": map[string]any{
+				"com.example.Address": map[string]any{"city": "Leeds"},
+			},
+			"tags": map[string]any{"array": []any{map[string]any{"string": "a"}}},
+		},
+	}
+
+	r := New(source, WithUnionUnwrap())
+
+	if result, err := r.StrErr("user.email"); err != nil || result != "jo@example.com" {
+		t.Errorf("Expected: jo@example.com but got: %s (%v)", result, err)
+	}
+
+	if result, err := r.IntErr("user.age"); err != nil || result != 30 {
+		t.Errorf("Expected: 30 but got: %d (%v)", result, err)
+	}
+
+	if result, err := r.StrErr("user.address.city"); err != nil || result != "Leeds" {
+		t.Errorf("Expected: Leeds but got: %s (%v)", result, err)
+	}
+
+	if result, err := r.StrErr("user.tags.0"); err != nil || result != "a" {
+		t.Errorf("Expected: a but got: %s (%v)", result, err)
+	}
+
+	if result, err := ReadErr[map[string]any](r, "user.email"); err != nil || result["string"] != "jo@example.com" {
+		t.Errorf("Unions should be returned as is when they match the requested type, got: %v (%v)", result, err)
+	}
+
+	if _, err := r.StrErr("user.nickname"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	if _, err := StrErr(source, "user.email"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Package level lookups shouldn't unwrap unions, but got: %v", err)
+	}
+}
+
+type testOptional struct {
+	value any
+	set   bool
+}
+
+func TestReaderUnwrapper(t *testing.T) {
+	source := map[string]any{
+		"cell":     map[string]any{"value": map[string]any{"name": "jo"}, "formatted": "jo"},
+		"optional": testOptional{value: int64(3), set: true},
+		"nested":   testOptional{value: testOptional{value: "deep", set: true}, set: true},
+		"unset":    testOptional{},
+	}
+
+	r := New(source,
+		WithUnwrapper(func(v any) (any, bool) {
+			o, ok := v.(testOptional)
+			if !ok || !o.set {
+				return nil, false
+			}
+			return o.value, true
+		}),
+		WithUnwrapper(func(v any) (any, bool) {
+			m, ok := v.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			value, ok := m["value"]
+			return value, ok
+		}),
+	)
+
+	if result, err := r.StrErr("cell.name"); err != nil || result != "jo" {
+		t.Errorf("Expected: jo but got: %s (%v)", result, err)
+	}
+
+	if result, err := r.StrErr("cell.formatted"); err != nil || result != "jo" {
+		t.Errorf("Keys present on the wrapper should still be found, expected: jo but got: %s (%v)", result, err)
+	}
+
+	if result, err := r.IntErr("optional"); err != nil || result != 3 {
+		t.Errorf("Expected: 3 but got: %d (%v)", result, err)
+	}
+
+	if result, err := r.StrErr("nested"); err != nil || result != "deep" {
+		t.Errorf("Expected: deep but got: %s (%v)", result, err)
+	}
+
+	if _, err := r.IntErr("unset"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	if _, err := StrErr(source, "cell.name"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Package level lookups shouldn't use custom unwrappers, but got: %v", err)
+	}
+}
+
+func TestSetDefaults(t *testing.T) {
+	previous := Defaults()
+	defer SetDefaults(previous)
+
+	source := map[string]any{"address": "1 Main St", "resource": map[string]any{"web": []any{map[string]any{"ami": "x"}}}}
+
+	SetDefaults(Options{SuggestKeys: true, UnwrapSingletonLists: true})
+
+	if _, err := StrErr(source, "adress"); err == nil || !strings.Contains(err.Error(), "did you mean 'address'") {
+		t.Errorf("Expected a key suggestion but got: %v", err)
+	}
+
+	if result := New(source).Str("resource.web.ami"); result != "x" {
+		t.Errorf("Expected New to inherit the defaults, but got: %s", result)
+	}
+
+	r := New(source, WithUnwrapper(func(any) (any, bool) { return nil, false }))
+	if len(Defaults().Unwrappers) != 0 || len(r.opts.Unwrappers) != 1 {
+		t.Errorf("Expected Reader options not to modify the defaults")
+	}
+
+	SetDefaults(previous)
+
+	if result := Str(source, "resource.web.ami"); result != "" {
+		t.Errorf("Expected restored defaults to stop unwrapping, but got: %s", result)
+	}
+}
+
+func TestReaderCaseInsensitiveKeys(t *testing.T) {
+	source := map[string]any{
+		"User": map[string]any{
+			"FirstName": "Jo",
+			"firstName": "exact",
+			"LastName":  "Bloggs",
+			"TAGS":      []any{"a"},
+			"Typed":     map[string]int{"Age": 42},
+		},
+		"lastname": "top",
+	}
+
+	r := New(source, WithCaseInsensitiveKeys())
+
+	tests := map[string]struct {
+		expected    string
+		expectedErr error
+	}{
+		"user.firstName": {expected: "exact"},
+		"user.firstname": {expected: "Jo"},
+		"USER.LASTNAME":  {expected: "Bloggs"},
+		"user.tags.0":    {expected: "a"},
+		"user.typed.age": {expected: "42"},
+		"lastname":       {expected: "top"},
+		"user.missing":   {expectedErr: ErrKeyNotFound},
+	}
+
+	for path, tc := range tests {
+		t.Run(path, func(t *testing.T) {
+			result, err := ReadErr[any](r, path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if tc.expectedErr == nil && fmt.Sprint(result) != tc.expected {
+				t.Errorf("Expected: %s but got: %v", tc.expected, result)
+			}
+		})
+	}
+
+	if _, err := StrErr(source, "user.firstname"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected keys to be case sensitive by default, but got: %v", err)
+	}
+}
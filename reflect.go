@@ -0,0 +1,248 @@
+package mapreader
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// reflectStep descends a single level into values that aren't JSON decoded shapes using reflection
+//
+// Maps of any key type, structs, typed slices and arrays, and pointers to any of these are supported.
+func reflectStep(current any, key string, literal bool, opts *Options) (any, error) {
+	v := reflect.ValueOf(current)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Map:
+		value, err := mapIndex(v, key)
+		if err != nil && opts.CaseInsensitiveKeys && v.Type().Key().Kind() == reflect.String {
+			for _, k := range childKeys(current) {
+				if strings.EqualFold(k, key) {
+					return mapIndex(v, k)
+				}
+			}
+		}
+
+		return value, err
+	case reflect.Struct:
+		f, ok := structField(v, key)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, key)
+		}
+
+		return f.Interface(), nil
+	case reflect.Slice, reflect.Array:
+		if field, value, ok := keySelector(key); ok && !literal {
+			return selectByKey(v.Len(), func(i int) any { return v.Index(i).Interface() }, field, value, opts)
+		}
+
+		if start, end, ok := sliceRange(key, v.Len()); ok && !literal {
+			if v.Kind() == reflect.Array && !v.CanAddr() {
+				v = addressable(v)
+			}
+
+			return v.Slice(start, end).Interface(), nil
+		}
+
+		i, err := segmentIndex(key, literal, v.Len())
+		if err != nil {
+			return nil, err
+		}
+
+		return v.Index(i).Interface(), nil
+	default:
+		return nil, fmt.Errorf("%w: last key was '%s'", ErrEndOfNestedStructures, key)
+	}
+}
+
+// structKeys returns the JSON names of the fields of a struct, as found by structField
+//
+// Fields of embedded structs are promoted, and oneof fields of generated protobuf messages are left out.
+func structKeys(v reflect.Value) []string {
+	var keys []string
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() && !f.Anonymous {
+			continue
+		}
+
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			embedded := v.Field(i)
+			if embedded.Kind() == reflect.Pointer {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+
+			if embedded.Kind() == reflect.Struct {
+				keys = append(keys, structKeys(embedded)...)
+				continue
+			}
+		}
+
+		if _, ok := f.Tag.Lookup("protobuf_oneof"); ok || !f.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		keys = append(keys, name)
+	}
+
+	return keys
+}
+
+// addressable returns an addressable copy of a value, so arrays can be sliced
+func addressable(v reflect.Value) reflect.Value {
+	p := reflect.New(v.Type()).Elem()
+	p.Set(v)
+
+	return p
+}
+
+// mapIndex looks up a key in a map of any key type, parsing the key into the map's key type
+//
+// String, integer and interface keyed maps are supported. For interface keys (e.g. map[any]any),
+// the key is tried as a string first, then as an int, then as each other integer type it fits in,
+// so maps with the int64 or uint64 keys of msgpack and CBOR decoders are also found.
+func mapIndex(m reflect.Value, key string) (any, error) {
+	for _, k := range mapKeys(key, m.Type().Key()) {
+		if v := m.MapIndex(k); v.IsValid() {
+			return v.Interface(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, key)
+}
+
+// intTypes and uintTypes are the integer key types tried for interface keyed maps, as produced by msgpack and CBOR decoders
+var (
+	intTypes = []reflect.Type{
+		reflect.TypeFor[int](), reflect.TypeFor[int8](), reflect.TypeFor[int16](),
+		reflect.TypeFor[int32](), reflect.TypeFor[int64](),
+	}
+	uintTypes = []reflect.Type{
+		reflect.TypeFor[uint](), reflect.TypeFor[uint8](), reflect.TypeFor[uint16](),
+		reflect.TypeFor[uint32](), reflect.TypeFor[uint64](),
+	}
+)
+
+// mapKeys returns the candidate values of the given key type that a path segment could represent
+func mapKeys(key string, t reflect.Type) []reflect.Value {
+	k := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.String:
+		k.SetString(key)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(key, 10, t.Bits())
+		if err != nil {
+			return nil
+		}
+		k.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		i, err := strconv.ParseUint(key, 10, t.Bits())
+		if err != nil {
+			return nil
+		}
+		k.SetUint(i)
+	case reflect.Interface:
+		keys := []reflect.Value{reflect.ValueOf(key)}
+		if i, err := strconv.ParseInt(key, 10, 64); err == nil {
+			for _, kt := range intTypes {
+				if v := reflect.New(kt).Elem(); !v.OverflowInt(i) {
+					v.SetInt(i)
+					keys = append(keys, v)
+				}
+			}
+		}
+
+		if u, err := strconv.ParseUint(key, 10, 64); err == nil {
+			for _, kt := range uintTypes {
+				if v := reflect.New(kt).Elem(); !v.OverflowUint(u) {
+					v.SetUint(u)
+					keys = append(keys, v)
+				}
+			}
+		}
+
+		return slices.DeleteFunc(keys, func(k reflect.Value) bool { return !k.Type().Implements(t) })
+	default:
+		return nil
+	}
+
+	return []reflect.Value{k}
+}
+
+// structField finds the exported field of a struct matching the key by its JSON name
+//
+// The JSON name is taken from the field's json tag, falling back to the field name when untagged.
+// Fields tagged with "-" are ignored, and fields of embedded structs are promoted as encoding/json does.
+// For generated protobuf messages, the field and JSON names from the protobuf tag also match,
+// as do the members of any oneof that are set.
+func structField(v reflect.Value, key string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() && !f.Anonymous {
+			continue
+		}
+
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			embedded := v.Field(i)
+			if embedded.Kind() == reflect.Pointer {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+
+			if embedded.Kind() == reflect.Struct {
+				if field, ok := structField(embedded, key); ok {
+					return field, true
+				}
+				continue
+			}
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		if _, ok := f.Tag.Lookup("protobuf_oneof"); ok {
+			if field, ok := oneofField(v.Field(i), key); ok {
+				return field, true
+			}
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		if name == key || slices.Contains(protobufNames(f), key) {
+			return v.Field(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
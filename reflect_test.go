@@ -0,0 +1,67 @@
+package mapreader
+
+import (
+	"errors"
+	"testing"
+)
+
+type testAddress struct {
+	Street   string `json:"street,omitempty"`
+	Postcode string
+	secret   string
+}
+
+type testAudit struct {
+	CreatedBy string `json:"created_by"`
+}
+
+type testCustomer struct {
+	*testAudit
+	Name      string         `json:"name"`
+	Addresses []testAddress  `json:"addresses"`
+	Ignored   string         `json:"-"`
+	Extra     map[string]int `json:"extra"`
+}
+
+func TestGetStructFields(t *testing.T) {
+	source := map[string]any{
+		"customer": &testCustomer{
+			testAudit: &testAudit{CreatedBy: "admin"},
+			Name:      "Jo",
+			Addresses: []testAddress{{Street: "High St", Postcode: "AB1", secret: "x"}},
+			Ignored:   "ignored",
+			Extra:     map[string]int{"visits": 3},
+		},
+	}
+
+	tests := []struct {
+		path        string
+		expected    any
+		expectedErr error
+	}{
+		{path: "customer.name", expected: "Jo"},
+		{path: "customer.created_by", expected: "admin"},
+		{path: "customer.addresses.0.street", expected: "High St"},
+		{path: "customer.addresses.0.Postcode", expected: "AB1"},
+		{path: "customer.extra.visits", expected: 3},
+		{path: "customer.Name", expectedErr: ErrKeyNotFound},
+		{path: "customer.Ignored", expectedErr: ErrKeyNotFound},
+		{path: "customer.addresses.0.secret", expectedErr: ErrKeyNotFound},
+		{path: "customer.addresses.1.street", expectedErr: ErrIndexOutOfBounds},
+		{path: "customer.addresses.first", expectedErr: ErrNonIntegerSliceAccess},
+		{path: "customer.name.first.x", expectedErr: ErrEndOfNestedStructures},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			result, err := GetErr[any](source, tc.path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("Expected: %#v but got: %#v", tc.expected, result)
+			}
+		})
+	}
+}
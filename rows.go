@@ -0,0 +1,80 @@
+//go:build go1.23
+
+package mapreader
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Rows returns an iterator over the elements of the slice found at the given lookup path, converted to the given type
+//
+// Elements are converted lazily as the iteration proceeds, so large slices aren't materialised as a []T.
+// Elements of the slice that are objects can be decoded into struct types, using their json tags.
+// If the slice can't be found, the iterator yields nothing, and elements that can't be converted are skipped.
+// Use mapreader.RowsErr if you would like errors to be returned
+func Rows[T any](source map[string]any, path string) iter.Seq2[int, T] {
+	return rows(RowsErr[T](source, path))
+}
+
+// Row is a single converted element yielded by mapreader.RowsErr
+type Row[T any] struct {
+	Value T
+	Err   error
+}
+
+// RowsErr returns an iterator over the elements of the slice found at the given lookup path, along with any errors
+//
+// A failure to find the slice is yielded once with an index of -1, while conversion
+// failures are yielded against the index of the element that failed.
+// Use mapreader.Rows if you would like to ignore errors
+func RowsErr[T any](source map[string]any, path string) iter.Seq2[int, Row[T]] {
+	return rowsErr[T](source, path, &defaultOptions)
+}
+
+// rows filters an iterator of rows down to the values that converted without error
+func rows[T any](seq iter.Seq2[int, Row[T]]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, row := range seq {
+			if row.Err != nil {
+				continue
+			}
+
+			if !yield(i, row.Value) {
+				return
+			}
+		}
+	}
+}
+
+// rowsErr iterates over the elements of the slice found at the given path, converting each lazily
+func rowsErr[T any](source map[string]any, path string, opts *Options) iter.Seq2[int, Row[T]] {
+	return func(yield func(int, Row[T]) bool) {
+		in, err := get(source, path, opts, asType[[]any])
+		if err != nil {
+			yield(-1, Row[T]{Err: err})
+			return
+		}
+
+		for i, v := range in {
+			value, err := asDecodedType[T](v)
+			if err != nil {
+				err = fmt.Errorf("index %d: %w", i, err)
+			}
+
+			if !yield(i, Row[T]{Value: value, Err: err}) {
+				return
+			}
+		}
+	}
+}
+
+// ReadRows is the Reader equivalent of mapreader.Rows
+func ReadRows[T any](r *Reader, path string) iter.Seq2[int, T] {
+	return rows(ReadRowsErr[T](r, path))
+}
+
+// ReadRowsErr is the Reader equivalent of mapreader.RowsErr
+func ReadRowsErr[T any](r *Reader, path string) iter.Seq2[int, Row[T]] {
+	return rowsErr[T](r.source, path, &r.opts)
+}
@@ -0,0 +1,63 @@
+//go:build go1.23
+
+package mapreader
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRows(t *testing.T) {
+	type item struct {
+		Name string  `json:"name"`
+		Qty  int     `json:"qty"`
+		Cost float64 `json:"cost"`
+	}
+
+	source := map[string]any{
+		"items": []any{
+			map[string]any{"name": "apple", "qty": float64(3), "cost": 0.5},
+			"not an item",
+			map[string]any{"name": "pear", "qty": float64(1), "cost": 0.75},
+		},
+	}
+
+	var names []string
+	var indexes []int
+	for i, row := range Rows[item](source, "items") {
+		names = append(names, row.Name)
+		indexes = append(indexes, i)
+	}
+
+	if len(names) != 2 || names[0] != "apple" || names[1] != "pear" || indexes[1] != 2 {
+		t.Errorf("Expected: [apple pear] at [0 2] but got: %v at %v", names, indexes)
+	}
+
+	for range Rows[item](source, "items") {
+		break
+	}
+
+	var errs []error
+	for i, row := range RowsErr[item](source, "items") {
+		if row.Err != nil {
+			errs = append(errs, row.Err)
+			if i != 1 {
+				t.Errorf("Expected: error at index 1 but got: %d", i)
+			}
+		}
+	}
+
+	if len(errs) != 1 || !errors.Is(errs[0], ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, errs)
+	}
+
+	for i, row := range RowsErr[item](source, "missing") {
+		if i != -1 || !errors.Is(row.Err, ErrKeyNotFound) {
+			t.Errorf("Expected error: %v, but got: %v (%d)", ErrKeyNotFound, row.Err, i)
+		}
+	}
+
+	for range Rows[string](source, "missing") {
+		t.Error("Rows of a missing slice should yield nothing")
+	}
+}
@@ -0,0 +1,161 @@
+package mapreader
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Scan looks up pairs of lookup paths and destination pointers, assigning each value found into its destination
+//
+// It's used much like database/sql's Rows.Scan, e.g. Scan(source, "user.id", &id, "user.email", &email).
+// Numeric destinations are converted as by mapreader.NumberErr, while other destinations
+// follow the same rules as mapreader.GetErr. Every pair is attempted, leaving the destinations of failed pairs untouched,
+// and the errors of all failed pairs are joined, each annotated with its path.
+func Scan(source map[string]any, pairs ...any) error {
+	return scan(source, &defaultOptions, pairs)
+}
+
+// scan assigns the values found at each path of the given pairs into their destinations
+func scan(source map[string]any, opts *Options, pairs []any) error {
+	if len(pairs)%2 != 0 {
+		return fmt.Errorf("%w: expected path and destination pairs, got %d arguments", ErrInvalidDestination, len(pairs))
+	}
+
+	for i := 0; i < len(pairs); i += 2 {
+		if _, ok := pairs[i].(string); !ok {
+			return fmt.Errorf("%w: argument %d should be a path, got %T", ErrInvalidDestination, i, pairs[i])
+		}
+	}
+
+	var errs []error
+	for i := 0; i < len(pairs); i += 2 {
+		path := pairs[i].(string)
+		if err := scanInto(source, path, pairs[i+1], opts); err != nil {
+			errs = append(errs, fmt.Errorf("path '%s': %w", path, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// scanInto assigns the value found at the given path into the destination pointer
+func scanInto(source map[string]any, path string, dst any, opts *Options) error {
+	switch d := dst.(type) {
+	case *string:
+		return scanValue(source, path, opts, d, asType[string])
+	case *bool:
+		return scanValue(source, path, opts, d, asType[bool])
+	case *[]byte:
+		return scanValue(source, path, opts, d, asBytes)
+	case *int:
+		return scanValue(source, path, opts, d, asNumber[int](opts))
+	case *int8:
+		return scanValue(source, path, opts, d, asNumber[int8](opts))
+	case *int16:
+		return scanValue(source, path, opts, d, asNumber[int16](opts))
+	case *int32:
+		return scanValue(source, path, opts, d, asNumber[int32](opts))
+	case *int64:
+		return scanValue(source, path, opts, d, asNumber[int64](opts))
+	case *uint:
+		return scanValue(source, path, opts, d, asNumber[uint](opts))
+	case *uint8:
+		return scanValue(source, path, opts, d, asNumber[uint8](opts))
+	case *uint16:
+		return scanValue(source, path, opts, d, asNumber[uint16](opts))
+	case *uint32:
+		return scanValue(source, path, opts, d, asNumber[uint32](opts))
+	case *uint64:
+		return scanValue(source, path, opts, d, asNumber[uint64](opts))
+	case *float32:
+		return scanValue(source, path, opts, d, asNumber[float32](opts))
+	case *float64:
+		return scanValue(source, path, opts, d, asNumber[float64](opts))
+	case *any:
+		return scanValue(source, path, opts, d, func(v any) (any, error) { return v, nil })
+	}
+
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return fmt.Errorf("%w: expected a non-nil pointer, got %T", ErrInvalidDestination, dst)
+	}
+
+	result, err := get(source, path, opts, func(in any) (reflect.Value, error) {
+		return asScanType(in, v.Type().Elem(), opts)
+	})
+	if err != nil {
+		return err
+	}
+
+	v.Elem().Set(result)
+	return nil
+}
+
+// scanValue assigns the value found at the given path into a destination of a known type
+func scanValue[T any](source map[string]any, path string, opts *Options, dst *T, convert func(any) (T, error)) error {
+	result, err := get(source, path, opts, convert)
+	if err != nil {
+		return err
+	}
+
+	*dst = result
+	return nil
+}
+
+// asScanType converts a value into the type of a destination
+//
+// Numeric types, including named ones such as time.Duration, are converted as by NumberErr,
+// falling back to the rules of GetErr so that e.g. enums implementing encoding.TextUnmarshaler can be read from text.
+func asScanType(in any, t reflect.Type, opts *Options) (reflect.Value, error) {
+	n, ok, err := asNumberKind(in, t.Kind(), opts)
+	if !ok {
+		return asTypeOf(in, t)
+	}
+
+	if err == nil {
+		return n.Convert(t), nil
+	}
+
+	if v, typeErr := asTypeOf(in, t); typeErr == nil {
+		return v, nil
+	}
+
+	return reflect.Value{}, err
+}
+
+// asNumberKind converts a value into a number of the given kind, or returns false if the kind isn't numeric
+func asNumberKind(in any, kind reflect.Kind, opts *Options) (reflect.Value, bool, error) {
+	var n any
+	var err error
+	switch kind {
+	case reflect.Int:
+		n, err = asNumber[int](opts)(in)
+	case reflect.Int8:
+		n, err = asNumber[int8](opts)(in)
+	case reflect.Int16:
+		n, err = asNumber[int16](opts)(in)
+	case reflect.Int32:
+		n, err = asNumber[int32](opts)(in)
+	case reflect.Int64:
+		n, err = asNumber[int64](opts)(in)
+	case reflect.Uint:
+		n, err = asNumber[uint](opts)(in)
+	case reflect.Uint8:
+		n, err = asNumber[uint8](opts)(in)
+	case reflect.Uint16:
+		n, err = asNumber[uint16](opts)(in)
+	case reflect.Uint32:
+		n, err = asNumber[uint32](opts)(in)
+	case reflect.Uint64:
+		n, err = asNumber[uint64](opts)(in)
+	case reflect.Float32:
+		n, err = asNumber[float32](opts)(in)
+	case reflect.Float64:
+		n, err = asNumber[float64](opts)(in)
+	default:
+		return reflect.Value{}, false, nil
+	}
+
+	return reflect.ValueOf(n), true, err
+}
@@ -0,0 +1,193 @@
+package mapreader
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestScan(t *testing.T) {
+	source := map[string]any{
+		"user": map[string]any{
+			"id":      float64(42),
+			"email":   "dan@example.com",
+			"joined":  "2023-01-02T03:04:05Z",
+			"color":   "red",
+			"roles":   []any{"admin"},
+			"profile": map[string]any{"active": true},
+		},
+		"items": []any{map[string]any{"qty": 3}},
+	}
+
+	var (
+		id      int64
+		email   string
+		qty     uint8
+		joined  time.Time
+		color   testColor
+		roles   []any
+		profile map[string]any
+		raw     any
+	)
+
+	err := Scan(source,
+		"user.id", &id,
+		"user.email", &email,
+		"items.0.qty", &qty,
+		"user.joined", &joined,
+		"user.color", &color,
+		"user.roles", &roles,
+		"user.profile", &profile,
+		"user.profile.active", &raw,
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if id != 42 || email != "dan@example.com" || qty != 3 || color != "red" || raw != true {
+		t.Errorf("Unexpected scanned values: %v, %v, %v, %v, %v", id, email, qty, color, raw)
+	}
+
+	if !joined.Equal(time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("Expected: 2023-01-02T03:04:05Z but got: %v", joined)
+	}
+
+	if !reflect.DeepEqual(roles, []any{"admin"}) || !reflect.DeepEqual(profile, map[string]any{"active": true}) {
+		t.Errorf("Unexpected scanned values: %v, %v", roles, profile)
+	}
+
+	tests := map[string]struct {
+		pairs       []any
+		expectedErr error
+	}{
+		"Missing key":         {pairs: []any{"user.name", &email}, expectedErr: ErrKeyNotFound},
+		"Wrong type":          {pairs: []any{"user.email", &id}, expectedErr: ErrUnexpectedType},
+		"Unconvertible value": {pairs: []any{"user.id", new(testPoint)}, expectedErr: ErrUnableToConvert},
+		"Unsupported type":    {pairs: []any{"user.id", new(struct{})}, expectedErr: ErrUnexpectedType},
+		"Odd arguments":       {pairs: []any{"user.id"}, expectedErr: ErrInvalidDestination},
+		"Non-string path":     {pairs: []any{1, &id}, expectedErr: ErrInvalidDestination},
+		"Non-pointer":         {pairs: []any{"user.color", color}, expectedErr: ErrInvalidDestination},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := Scan(source, tc.pairs...); !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestScanTypedContainers(t *testing.T) {
+	source := map[string]any{
+		"tags":   []string{"a", "b"},
+		"labels": map[string]string{"app": "web"},
+	}
+
+	var (
+		tags   []any
+		labels map[string]any
+	)
+
+	if err := Scan(source, "tags", &tags, "labels", &labels); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(tags, []any{"a", "b"}) || !reflect.DeepEqual(labels, map[string]any{"app": "web"}) {
+		t.Errorf("Unexpected scanned values: %v, %v", tags, labels)
+	}
+
+	var labelTags []any
+	if err := Scan(source, "labels", &labelTags); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+}
+
+type testLevel int
+
+func (l *testLevel) UnmarshalText(text []byte) error {
+	levels := map[string]testLevel{"debug": 0, "info": 1, "warn": 2}
+	level, ok := levels[string(text)]
+	if !ok {
+		return errors.New("unknown level")
+	}
+
+	*l = level
+	return nil
+}
+
+func TestScanNamedTypes(t *testing.T) {
+	source := map[string]any{
+		"timeout": float64(1500),
+		"level":   "warn",
+		"code":    float64(2),
+		"point":   []any{float64(1), float64(2)},
+		"ratio":   0.5,
+		"big":     float64(300),
+	}
+
+	var (
+		timeout time.Duration
+		level   testLevel
+		code    testLevel
+		point   *testPoint
+		ratio   testRatio
+	)
+
+	err := Scan(source,
+		"timeout", &timeout,
+		"level", &level,
+		"code", &code,
+		"point", &point,
+		"ratio", &ratio,
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if timeout != 1500 || level != 2 || code != 2 || ratio != 0.5 {
+		t.Errorf("Unexpected scanned values: %v, %v, %v, %v", timeout, level, code, ratio)
+	}
+
+	if point == nil || *point != (testPoint{X: 1, Y: 2}) {
+		t.Errorf("Expected: &{1 2} but got: %v", point)
+	}
+
+	var small testSmall
+	if err := Scan(source, "big", &small); !errors.Is(err, ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, err)
+	}
+
+	if err := Scan(source, "ratio", &code); !errors.Is(err, ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, err)
+	}
+
+	if err := New(map[string]any{"timeout": "250"}, WithNumberParsing()).Scan("timeout", &timeout); err != nil || timeout != 250 {
+		t.Errorf("Expected: 250ns but got: %v (%v)", timeout, err)
+	}
+}
+
+type testRatio float32
+
+type testSmall uint8
+
+func TestScanAggregatesErrors(t *testing.T) {
+	source := map[string]any{"a": map[string]any{"b": "Dan"}, "c": []any{map[string]any{"d": float64(3)}}}
+
+	var (
+		name    string
+		missing string
+		count   int
+		wrong   bool
+	)
+
+	err := Scan(source, "a.x", &missing, "a.b", &name, "a.b", &wrong, "c.0.d", &count)
+	if !errors.Is(err, ErrKeyNotFound) || !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected both ErrKeyNotFound and ErrUnexpectedType, but got: %v", err)
+	}
+
+	if name != "Dan" || count != 3 || missing != "" || wrong {
+		t.Errorf("Unexpected scanned values: %q, %d, %q, %v", name, count, missing, wrong)
+	}
+}
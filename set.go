@@ -0,0 +1,339 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Set writes the value at the given lookup path, ignoring any errors
+//
+// Use mapreader.SetErr if you would like errors to be returned
+func Set[T any](source map[string]any, path string, value T) {
+	_ = SetErr(source, path, value)
+}
+
+// SetErr writes the value at the given lookup path, or returns an error
+//
+// The path uses the same syntax as the getters, and everything but its final segment must already exist.
+// The final segment may add or replace a key of a map, or replace an existing element of a slice.
+// Typed maps, slices and (addressable) structs are written via reflection, provided the value is assignable.
+func SetErr[T any](source map[string]any, path string, value T) error {
+	return set(source, path, value, &defaultOptions)
+}
+
+// Append appends the value to the slice at the given lookup path, ignoring any errors
+//
+// Use mapreader.AppendErr if you would like errors to be returned
+func Append[T any](source map[string]any, path string, value T) {
+	_ = AppendErr(source, path, value)
+}
+
+// AppendErr appends the value to the slice at the given lookup path, or returns an error
+//
+// The grown slice is written back in place of the original, so the source sees the appended value.
+// As with JSON Pointer, a final "-" segment refers to the end of the slice, so "items" and "items.-" are equivalent.
+// A "-" segment is also accepted by SetErr, where it appends to a slice rather than writing a map key.
+func AppendErr[T any](source map[string]any, path string, value T) error {
+	if parent, key, _ := cutLastSegment(path); key == "-" {
+		if s, err := lookup(source, parent, &defaultOptions); err == nil && isSlice(s) {
+			path = parent
+		}
+	}
+
+	return appendAt(source, path, value, &defaultOptions)
+}
+
+// SetCreate writes the value at the given lookup path, creating any missing containers and ignoring any errors
+//
+// Use mapreader.SetCreateErr if you would like errors to be returned
+func SetCreate[T any](source map[string]any, path string, value T) {
+	_ = SetCreateErr(source, path, value)
+}
+
+// SetCreateErr writes the value at the given lookup path, creating any missing containers, or returns an error
+//
+// e.g. SetCreateErr(source, "a.b.0.c", 1) on an empty map results in {"a": {"b": [{"c": 1}]}}
+// Missing containers are created as []any where the next segment is an index, otherwise as map[string]any.
+// Slices are grown to fit an index beyond their end, with any gaps filled by nil, and a "-" segment appends to them.
+// An index more than 1024 beyond the end of a slice returns ErrIndexOutOfBounds, rather than allocating the gap.
+// Existing values which aren't containers are never replaced, and return ErrEndOfNestedStructures.
+func SetCreateErr[T any](source map[string]any, path string, value T) error {
+	_, err := setCreate(source, splitPath(path), value, &defaultOptions)
+	return err
+}
+
+// SetStruct writes a struct at the given lookup path as a nested map[string]any, ignoring any errors
+//
+// Use mapreader.SetStructErr if you would like errors to be returned
+func SetStruct(source map[string]any, path string, v any) {
+	_ = SetStructErr(source, path, v)
+}
+
+// SetStructErr writes a struct at the given lookup path as a nested map[string]any, or returns an error
+//
+// The struct is converted through its JSON encoding, so its json tags are respected, and the result is decoded
+// as by mapreader.FromJSON, with nested structs and slices becoming map[string]any and []any.
+// The path follows the same rules as mapreader.SetErr.
+func SetStructErr(source map[string]any, path string, v any) error {
+	value, err := encodeValue(v)
+	if err != nil {
+		return err
+	}
+
+	return set(source, path, value, &defaultOptions)
+}
+
+// set writes the value at the given path, using the given options to resolve the parent of the final segment
+func set(source map[string]any, path string, value any, opts *Options) error {
+	parentPath, key, hasParent := cutLastSegment(path)
+
+	var parent any = source
+	if hasParent {
+		var err error
+		if parent, err = lookup(source, parentPath, opts); err != nil {
+			return err
+		}
+	}
+
+	if key == "-" && hasParent && isSlice(parent) {
+		return appendAt(source, parentPath, value, opts)
+	}
+
+	return setChild(parent, key, value, opts)
+}
+
+// appendAt appends the value to the slice at the given path, writing the grown slice back in its place
+func appendAt(source map[string]any, path string, value any, opts *Options) error {
+	current, err := lookup(source, path, opts)
+	if err != nil {
+		return err
+	}
+
+	if s, ok := current.([]any); ok {
+		return set(source, path, append(s, value), opts)
+	}
+
+	v := reflect.ValueOf(current)
+	if v.Kind() != reflect.Slice {
+		return fmt.Errorf("%w: expected a slice but got '%T'", ErrUnexpectedType, current)
+	}
+
+	var grown reflect.Value
+	err = assignValue(value, v.Type().Elem(), func(e reflect.Value) { grown = reflect.Append(v, e) })
+	if err != nil {
+		return err
+	}
+
+	return set(source, path, grown.Interface(), opts)
+}
+
+// encodeValue converts a value into its generic JSON form, made of map[string]any, []any and scalar values
+func encodeValue(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnableToConvert, err)
+	}
+
+	value, err := decodeJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnableToConvert, err)
+	}
+
+	return value, nil
+}
+
+// isSlice reports whether a value is a slice
+func isSlice(value any) bool {
+	return reflect.ValueOf(value).Kind() == reflect.Slice
+}
+
+// setChild writes the value into the given key of a container
+func setChild(parent any, key string, value any, opts *Options) error {
+	switch p := parent.(type) {
+	case map[string]any:
+		p[key] = value
+		return nil
+	case []any:
+		i, err := setIndex(len(p), func(i int) any { return p[i] }, key, opts)
+		if err != nil {
+			return err
+		}
+
+		p[i] = value
+		return nil
+	}
+
+	v := reflect.ValueOf(parent)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Map:
+		keys := mapKeys(key, v.Type().Key())
+		if len(keys) == 0 {
+			return fmt.Errorf("%w: '%s' isn't a valid %s key", ErrUnexpectedType, key, v.Type().Key())
+		}
+
+		k := keys[0]
+		for _, candidate := range keys {
+			if v.MapIndex(candidate).IsValid() {
+				k = candidate
+				break
+			}
+		}
+
+		return assignValue(value, v.Type().Elem(), func(e reflect.Value) { v.SetMapIndex(k, e) })
+	case reflect.Slice, reflect.Array:
+		i, err := setIndex(v.Len(), func(i int) any { return v.Index(i).Interface() }, key, opts)
+		if err != nil {
+			return err
+		}
+
+		if !v.Index(i).CanSet() {
+			return fmt.Errorf("%w: %s isn't addressable", ErrUnexpectedType, v.Type())
+		}
+
+		return assignValue(value, v.Type().Elem(), v.Index(i).Set)
+	case reflect.Struct:
+		f, ok := structField(v, key)
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrKeyNotFound, key)
+		}
+
+		if !f.CanSet() {
+			return fmt.Errorf("%w: %s field '%s' isn't addressable", ErrUnexpectedType, v.Type(), key)
+		}
+
+		return assignValue(value, f.Type(), f.Set)
+	default:
+		return fmt.Errorf("%w: last key was '%s'", ErrEndOfNestedStructures, key)
+	}
+}
+
+// maxSliceGap limits the number of nil elements setCreate will fill when growing a slice to an index beyond its end
+const maxSliceGap = 1024
+
+// setCreate writes the value beneath the given keys of current, creating any missing containers
+//
+// The container is returned, as growing a slice (or creating a missing one) requires its parent to be updated.
+func setCreate(current any, keys []string, value any, opts *Options) (any, error) {
+	if len(keys) == 0 {
+		return value, nil
+	}
+
+	key := keys[0]
+	if current == nil {
+		if _, err := strconv.Atoi(key); err == nil || key == "-" {
+			current = []any{}
+		} else {
+			current = map[string]any{}
+		}
+	}
+
+	switch c := current.(type) {
+	case map[string]any:
+		child, err := setCreate(c[key], keys[1:], value, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		c[key] = child
+		return c, nil
+	case []any:
+		i, err := strconv.Atoi(key)
+		if key == "-" {
+			i, err = len(c), nil
+		}
+
+		if err != nil || i < len(c) {
+			i, err = setIndex(len(c), func(i int) any { return c[i] }, key, opts)
+			if err != nil {
+				return nil, err
+			}
+		} else if i-len(c) > maxSliceGap {
+			return nil, fmt.Errorf("%w: index %d is more than %d beyond the end of the slice", ErrIndexOutOfBounds, i, maxSliceGap)
+		} else {
+			c = append(c, make([]any, i+1-len(c))...)
+		}
+
+		child, err := setCreate(c[i], keys[1:], value, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		c[i] = child
+		return c, nil
+	}
+
+	if len(keys) == 1 {
+		return current, setChild(current, key, value, opts)
+	}
+
+	child, err := step(current, key, opts)
+	if errors.Is(err, ErrKeyNotFound) {
+		child = nil
+	} else if err != nil {
+		return nil, err
+	} else if !isContainer(child) {
+		return nil, fmt.Errorf("%w: last key was '%s'", ErrEndOfNestedStructures, keys[1])
+	}
+
+	child, err = setCreate(child, keys[1:], value, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return current, setChild(current, key, child, opts)
+}
+
+// isContainer reports whether a value can be traversed by a further path segment
+func isContainer(value any) bool {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.Struct:
+		return true
+	default:
+		return false
+	}
+}
+
+// setIndex returns the index of the slice element that a path segment refers to
+func setIndex(length int, element func(int) any, key string, opts *Options) (int, error) {
+	field, value, ok := keySelector(key)
+	if !ok {
+		return sliceIndex(key, length)
+	}
+
+	for i := 0; i < length; i++ {
+		if v, err := keyField(element(i), field, opts); err == nil && matchesKey(v, value) {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: no element with %s=%s", ErrKeyNotFound, field, value)
+}
+
+// assignValue assigns a value to a reflected destination of the given type, if it's assignable
+func assignValue(value any, t reflect.Type, assign func(reflect.Value)) error {
+	v := reflect.ValueOf(value)
+	if value == nil {
+		switch t.Kind() {
+		case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice:
+			assign(reflect.Zero(t))
+			return nil
+		}
+	} else if v.Type().AssignableTo(t) {
+		assign(v)
+		return nil
+	}
+
+	return fmt.Errorf("%w: %T can't be assigned to %s", ErrUnexpectedType, value, t)
+}
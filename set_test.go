@@ -0,0 +1,263 @@
+package mapreader
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSetErr(t *testing.T) {
+	type server struct {
+		Port int `json:"port"`
+	}
+
+	tests := map[string]struct {
+		path        string
+		value       any
+		expectedErr error
+	}{
+		"top level key":         {path: "name", value: "new"},
+		"nested key":            {path: "user.name", value: "new"},
+		"new nested key":        {path: "user.email", value: "a@example.com"},
+		"slice index":           {path: "tags.1", value: "new"},
+		"keyed slice element":   {path: "users.[id=42].name", value: "new"},
+		"typed map":             {path: "limits.cpu", value: 4},
+		"typed slice":           {path: "ports.0", value: 8080},
+		"struct pointer field":  {path: "server.port", value: 9090},
+		"escaped key":           {path: `hosts.example\.com`, value: "new"},
+		"missing parent":        {path: "missing.name", expectedErr: ErrKeyNotFound},
+		"index out of bounds":   {path: "tags.5", expectedErr: ErrIndexOutOfBounds},
+		"non-integer index":     {path: "tags.x", expectedErr: ErrNonIntegerSliceAccess},
+		"through a leaf":        {path: "name.first", expectedErr: ErrEndOfNestedStructures},
+		"wrong typed map value": {path: "limits.cpu", value: "four", expectedErr: ErrUnexpectedType},
+		"unknown struct field":  {path: "server.host", value: "x", expectedErr: ErrKeyNotFound},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			source := map[string]any{
+				"name":   "old",
+				"user":   map[string]any{"name": "old"},
+				"tags":   []any{"a", "b"},
+				"users":  []any{map[string]any{"id": 7.0, "name": "jo"}, map[string]any{"id": 42.0, "name": "dan"}},
+				"limits": map[string]int{"cpu": 2},
+				"ports":  []int{80},
+				"server": &server{Port: 80},
+				"hosts":  map[string]any{"example.com": "old"},
+			}
+
+			err := SetErr(source, tc.path, tc.value)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if tc.expectedErr != nil {
+				return
+			}
+
+			result, err := GetErr[any](source, tc.path)
+			if err != nil {
+				t.Fatalf("Unexpected error reading back value: %v", err)
+			}
+
+			if result != tc.value {
+				t.Errorf("Expected: %v but got: %v", tc.value, result)
+			}
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	source := map[string]any{"user": map[string]any{}}
+
+	Set(source, "user.name", "jo")
+	Set(source, "missing.name", "ignored")
+
+	if result := Str(source, "user.name"); result != "jo" {
+		t.Errorf("Expected: jo but got: %s", result)
+	}
+}
+
+func TestSetCreateErr(t *testing.T) {
+	type server struct {
+		Labels map[string]any
+	}
+
+	tests := map[string]struct {
+		path        string
+		value       any
+		expectedErr error
+	}{
+		"existing key":          {path: "user.name", value: "new"},
+		"missing maps":          {path: "a.b.c", value: "new"},
+		"missing slice":         {path: "a.b.0.c", value: "new"},
+		"grown slice":           {path: "tags.3", value: "new"},
+		"grown nested slice":    {path: "tags.4.name", value: "new"},
+		"existing element":      {path: "users.[id=42].email", value: "new"},
+		"missing keyed element": {path: "users.[id=99].email", expectedErr: ErrKeyNotFound},
+		"struct field":          {path: "server.Labels.env", value: "prod"},
+		"nested struct field":   {path: "server.Labels.env.name", value: "prod"},
+		"through a leaf":        {path: "user.name.first", expectedErr: ErrEndOfNestedStructures},
+		"non-integer index":     {path: "tags.x", expectedErr: ErrNonIntegerSliceAccess},
+		"largest gap":           {path: "tags.1026", value: "new"},
+		"gap too large":         {path: "tags.1027", expectedErr: ErrIndexOutOfBounds},
+		"huge index":            {path: "a.1000000000", expectedErr: ErrIndexOutOfBounds},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			source := map[string]any{
+				"user":   map[string]any{"name": "old"},
+				"tags":   []any{"a", "b"},
+				"users":  []any{map[string]any{"id": 7.0}, map[string]any{"id": 42.0}},
+				"server": &server{Labels: map[string]any{}},
+			}
+
+			err := SetCreateErr(source, tc.path, tc.value)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if tc.expectedErr != nil {
+				return
+			}
+
+			result, err := GetErr[any](source, tc.path)
+			if err != nil {
+				t.Fatalf("Unexpected error reading back value: %v", err)
+			}
+
+			if result != tc.value {
+				t.Errorf("Expected: %v but got: %v", tc.value, result)
+			}
+		})
+	}
+}
+
+func TestSetCreateGrowsSlices(t *testing.T) {
+	source := map[string]any{}
+
+	SetCreate(source, "a.b.1.c", 1)
+
+	b, _ := GetErr[[]any](source, "a.b")
+	if len(b) != 2 || b[0] != nil {
+		t.Fatalf("Expected: [<nil> map[c:1]] but got: %v", b)
+	}
+
+	if result := Int(source, "a.b.1.c"); result != 1 {
+		t.Errorf("Expected: 1 but got: %d", result)
+	}
+}
+
+func TestAppendErr(t *testing.T) {
+	tests := map[string]struct {
+		path        string
+		value       any
+		expected    any
+		expectedErr error
+	}{
+		"slice":             {path: "tags", value: "c", expected: []any{"a", "b", "c"}},
+		"dash segment":      {path: "tags.-", value: "c", expected: []any{"a", "b", "c"}},
+		"nested slice":      {path: "user.roles", value: "admin", expected: []any{"admin"}},
+		"typed slice":       {path: "ports", value: 443, expected: []int{80, 443}},
+		"wrong typed value": {path: "ports", value: "443", expectedErr: ErrUnexpectedType},
+		"not a slice":       {path: "user", value: "x", expectedErr: ErrUnexpectedType},
+		"missing path":      {path: "missing", value: "x", expectedErr: ErrKeyNotFound},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			source := map[string]any{
+				"tags":  []any{"a", "b"},
+				"user":  map[string]any{"roles": []any{}},
+				"ports": []int{80},
+			}
+
+			err := AppendErr(source, tc.path, tc.value)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if tc.expectedErr != nil {
+				return
+			}
+
+			result, _ := GetErr[any](source, strings.TrimSuffix(tc.path, ".-"))
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestSetDashSegment(t *testing.T) {
+	source := map[string]any{"tags": []any{"a"}, "m": map[string]any{}}
+
+	if err := SetErr(source, "tags.-", "b"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := SetErr(source, "m.-", "dash"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if err := SetCreateErr(source, "list.-.name", "first"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result := Str(source, "tags.1"); result != "b" {
+		t.Errorf("Expected: b but got: %s", result)
+	}
+
+	if result := Str(source, `m.-`); result != "dash" {
+		t.Errorf("Expected: dash but got: %s", result)
+	}
+
+	if result := Str(source, "list.0.name"); result != "first" {
+		t.Errorf("Expected: first but got: %s", result)
+	}
+}
+
+func TestSetStructErr(t *testing.T) {
+	type address struct {
+		Street string   `json:"street"`
+		Number int      `json:"number"`
+		Lines  []string `json:"lines,omitempty"`
+		Secret string   `json:"-"`
+	}
+
+	type customer struct {
+		Name    string   `json:"name"`
+		Address *address `json:"address"`
+	}
+
+	source := map[string]any{"order": map[string]any{"id": 1}}
+	err := SetStructErr(source, "order.customer", customer{
+		Name:    "Dan",
+		Address: &address{Street: "High St", Number: 1, Secret: "x"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := map[string]any{
+		"name":    "Dan",
+		"address": map[string]any{"street": "High St", "number": int64(1)},
+	}
+	if !reflect.DeepEqual(source["order"].(map[string]any)["customer"], expected) {
+		t.Errorf("Expected: %v but got: %v", expected, source["order"])
+	}
+
+	if street := Str(source, "order.customer.address.street"); street != "High St" {
+		t.Errorf("Expected: High St but got: %v", street)
+	}
+
+	if err := SetStructErr(source, "missing.customer", customer{}); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Expected error: %v, but got: %v", ErrKeyNotFound, err)
+	}
+
+	if err := SetStructErr(source, "order.fn", func() {}); !errors.Is(err, ErrUnableToConvert) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnableToConvert, err)
+	}
+}
@@ -0,0 +1,214 @@
+package mapreader
+
+import (
+	"log/slog"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// redacted replaces the value of any redacted path when logging
+const redacted = "[REDACTED]"
+
+// LogValue renders the value found at the given lookup path as a slog.Value, masking any of the redacted paths
+//
+// Maps, slices and structs are rendered as groups, with slice elements keyed by their index.
+// Redacted paths are full lookup paths from the root of the source, e.g. "user.password",
+// and may use "*" to match every key or index of a level, e.g. "users.*.password".
+// Values that would need to be searched for redacted paths, but can't be, are masked whole.
+// An empty path renders the whole source. If the lookup fails, the error is rendered instead.
+func LogValue(source map[string]any, path string, redactPaths ...string) slog.Value {
+	return logValueOf(source, path, &defaultOptions, redactPaths)
+}
+
+// LogValue implements slog.LogValuer, rendering the whole source with any redacted paths masked
+//
+// See mapreader.WithRedactedPaths for configuring which paths are masked.
+func (r *Reader) LogValue() slog.Value {
+	return logValueOf(r.source, "", &r.opts, r.opts.RedactPaths)
+}
+
+// redaction holds the remaining segments of each redacted path that may match beneath a value being logged
+type redaction [][]redactSegment
+
+// redactSegment is a segment of a redacted path
+type redactSegment struct {
+	key      string
+	literal  bool
+	wildcard bool
+}
+
+// newRedaction splits each of the redacted paths into their segments
+func newRedaction(paths []string) redaction {
+	r := make(redaction, 0, len(paths))
+	for _, path := range paths {
+		var segments []redactSegment
+		for remaining := path; remaining != ""; {
+			key, rest, more, literal := cutLiteralSegment(remaining)
+			raw := strings.TrimSuffix(remaining[:len(remaining)-len(rest)], ".")
+			segments = append(segments, redactSegment{key: key, literal: literal, wildcard: raw == "*" || raw == "[*]"})
+			if !more {
+				break
+			}
+			remaining = rest
+		}
+		r = append(r, segments)
+	}
+
+	return r
+}
+
+// child returns the redaction remaining beneath the child of a container with the given key,
+// and whether the child is itself redacted
+//
+// Segments are resolved against the container as a lookup would, so e.g. "-1" matches the last index of a slice.
+func (r redaction) child(container any, key string, opts *Options) (redaction, bool) {
+	var remaining redaction
+	for _, segments := range r {
+		if len(segments) == 0 {
+			return nil, true
+		}
+
+		if !segments[0].wildcard && childKey(container, segments[0].key, segments[0].literal, opts) != key {
+			continue
+		}
+
+		if len(segments) == 1 {
+			return nil, true
+		}
+		remaining = append(remaining, segments[1:])
+	}
+
+	return remaining, false
+}
+
+// childKey returns the key of the child of a container selected by a path segment, as it is keyed when logged
+func childKey(container any, key string, literal bool, opts *Options) string {
+	v := reflect.ValueOf(container)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if i, err := segmentIndex(key, literal, v.Len()); err == nil {
+			return strconv.Itoa(i)
+		}
+	case reflect.Map:
+		if _, err := mapIndex(v, key); err != nil && opts.CaseInsensitiveKeys {
+			for _, k := range childKeys(container) {
+				if strings.EqualFold(k, key) {
+					return k
+				}
+			}
+		}
+	}
+
+	return key
+}
+
+// logValueOf looks up the value to be logged and renders it
+func logValueOf(source map[string]any, path string, opts *Options, redactPaths []string) slog.Value {
+	redact := newRedaction(redactPaths)
+	if path == "" {
+		return logValue(source, redact, opts)
+	}
+
+	value, err := lookup(source, path, opts)
+	if err != nil {
+		return slog.AnyValue(err)
+	}
+
+	base, _ := cutModifiers(path)
+	var current any = source
+	for remaining := base; ; {
+		key, rest, more, literal := cutLiteralSegment(remaining)
+
+		var masked bool
+		if redact, masked = redact.child(current, childKey(current, key, literal, opts), opts); masked {
+			return slog.StringValue(redacted)
+		}
+
+		if current, err = stepSegment(current, key, literal, opts); err != nil || !more {
+			break
+		}
+		remaining = rest
+	}
+
+	return logValue(value, redact, opts)
+}
+
+// logValue recursively renders a value as a slog.Value, masking values at any redacted path
+func logValue(value any, redact redaction, opts *Options) slog.Value {
+	keys, ok := logKeys(value, len(redact) > 0)
+	if !ok {
+		if len(redact) > 0 && !isScalar(value) {
+			return slog.StringValue(redacted)
+		}
+
+		return slog.AnyValue(value)
+	}
+
+	attrs := make([]slog.Attr, len(keys))
+	for i, k := range keys {
+		remaining, masked := redact.child(value, k, opts)
+		if masked {
+			attrs[i] = slog.String(k, redacted)
+			continue
+		}
+
+		child, err := step(value, k, opts)
+		if err != nil && len(remaining) > 0 {
+			attrs[i] = slog.String(k, redacted)
+			continue
+		}
+		if err != nil {
+			attrs[i] = slog.Any(k, err)
+			continue
+		}
+
+		attrs[i] = slog.Attr{Key: k, Value: logValue(child, remaining, opts)}
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// logKeys returns the keys of a value to be rendered as a group, or false if it should be rendered as it is
+//
+// Byte slices, such as json.RawMessage, are always rendered as they are.
+// Structs are only rendered as groups when they need to be searched for redacted paths,
+// so that values such as time.Time keep their usual form.
+func logKeys(value any, searched bool) ([]string, bool) {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return nil, false
+		}
+		return childKeys(value), true
+	case reflect.Map:
+		return childKeys(value), true
+	case reflect.Struct:
+		keys := structKeys(v)
+		return keys, searched && len(keys) > 0
+	default:
+		return nil, false
+	}
+}
+
+// isScalar reports whether a value has nothing beneath it that could be redacted
+func isScalar(value any) bool {
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Invalid, reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,106 @@
+package mapreader
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+)
+
+type testLogUser struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+func TestLogValue(t *testing.T) {
+	source := map[string]any{}
+	err := json.Unmarshal([]byte(`{
+		"user": {"name": "jo", "password": "hunter2", "keys": ["k1", "k2"]},
+		"id": 1
+	}`), &source)
+	if err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	typed := map[string]any{
+		"user":  map[string]string{"name": "jo", "password": "hunter2"},
+		"users": []testLogUser{{Name: "jo", Password: "hunter2"}, {Name: "sam", Password: "letmein"}},
+		"raw":   json.RawMessage(`{"password": "hunter2"}`),
+	}
+
+	tests := []struct {
+		name     string
+		value    slog.Value
+		expected string
+	}{
+		{
+			name:     "Typed map",
+			value:    LogValue(typed, "user", "user.password"),
+			expected: "v.name=jo v.password=[REDACTED]\n",
+		},
+		{
+			name:     "Structs with a wildcard",
+			value:    LogValue(typed, "users", "users.*.password"),
+			expected: "v.0.name=jo v.0.password=[REDACTED] v.1.name=sam v.1.password=[REDACTED]\n",
+		},
+		{
+			name:     "Negative index",
+			value:    LogValue(typed, "users.-1", "users.1.password"),
+			expected: "v.name=sam v.password=[REDACTED]\n",
+		},
+		{
+			name:     "Negative redacted index",
+			value:    LogValue(source, "user.keys", "user.keys.-1"),
+			expected: "v.0=k1 v.1=[REDACTED]\n",
+		},
+		{
+			name:     "Unsearchable value",
+			value:    LogValue(typed, "", "raw.password"),
+			expected: "v.raw=[REDACTED] v.user.name=jo v.user.password=hunter2 v.users.0=\"{Name:jo Password:hunter2}\" v.users.1=\"{Name:sam Password:letmein}\"\n",
+		},
+		{
+			name:     "Subtree with redaction",
+			value:    LogValue(source, "user", "user.password", "user.keys.1"),
+			expected: "v.keys.0=k1 v.keys.1=[REDACTED] v.name=jo v.password=[REDACTED]\n",
+		},
+		{
+			name:     "Whole source",
+			value:    LogValue(source, "", "user"),
+			expected: "v.id=1 v.user=[REDACTED]\n",
+		},
+		{
+			name:     "Scalar",
+			value:    LogValue(source, "user.name"),
+			expected: "v=jo\n",
+		},
+		{
+			name:     "Missing",
+			value:    LogValue(source, "user.email"),
+			expected: "v=\"key not found: email\"\n",
+		},
+		{
+			name:     "Reader",
+			value:    slog.AnyValue(New(source, WithRedactedPaths("user.password", "user.keys"))),
+			expected: "v.id=1 v.user.keys=[REDACTED] v.user.name=jo v.user.password=[REDACTED]\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			handler := slog.NewTextHandler(&buf, &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+					if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey || a.Key == slog.MessageKey) {
+						return slog.Attr{}
+					}
+					return a
+				},
+			})
+			slog.New(handler).Info("", "v", tc.value)
+
+			if buf.String() != tc.expected {
+				t.Errorf("Expected: %q but got: %q", tc.expected, buf.String())
+			}
+		})
+	}
+}
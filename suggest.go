@@ -0,0 +1,89 @@
+package mapreader
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// maxSuggestions limits the number of keys suggested for a missing key
+const maxSuggestions = 3
+
+// keyNotFoundError returns the error for a key missing from a map, suggesting the closest keys if enabled by the options
+func keyNotFoundError(key string, m map[string]any, opts *Options) error {
+	if !opts.SuggestKeys {
+		return fmt.Errorf("%w: %s", ErrKeyNotFound, key)
+	}
+
+	suggestions := suggestKeys(key, m)
+	if len(suggestions) == 0 {
+		return fmt.Errorf("%w: %s", ErrKeyNotFound, key)
+	}
+
+	return fmt.Errorf("%w: %s, did you mean '%s'?", ErrKeyNotFound, key, strings.Join(suggestions, "' or '"))
+}
+
+// suggestKeys returns the keys of the map closest to the given key by edit distance, closest first
+//
+// Only keys within an edit distance of a third of the key's length (and at least 1) are suggested.
+func suggestKeys(key string, m map[string]any) []string {
+	type candidate struct {
+		key      string
+		distance int
+	}
+
+	limit := max(1, len(key)/3)
+
+	var candidates []candidate
+	for k := range m {
+		if d := editDistance(key, k); d <= limit {
+			candidates = append(candidates, candidate{key: k, distance: d})
+		}
+	}
+
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		if a.distance != b.distance {
+			return a.distance - b.distance
+		}
+
+		return strings.Compare(a.key, b.key)
+	})
+
+	suggestions := make([]string, 0, min(len(candidates), maxSuggestions))
+	for _, c := range candidates[:min(len(candidates), maxSuggestions)] {
+		suggestions = append(suggestions, c.key)
+	}
+
+	return suggestions
+}
+
+// editDistance returns the optimal string alignment distance between two strings
+//
+// This is the Levenshtein distance, with transpositions of adjacent characters also counting as a single edit.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prevPrev := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				curr[j] = min(curr[j], prevPrev[j-2]+1)
+			}
+		}
+		prevPrev, prev, curr = prev, curr, prevPrev
+	}
+
+	return prev[len(rb)]
+}
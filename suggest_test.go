@@ -0,0 +1,58 @@
+package mapreader
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWithKeySuggestions(t *testing.T) {
+	source := map[string]any{
+		"user": map[string]any{"address": "1 Road", "addresses": []any{}, "name": "Dan"},
+	}
+
+	r := New(source, WithKeySuggestions())
+
+	tests := map[string]string{
+		"user.adress":    "key not found: adress, did you mean 'address'?",
+		"user.addresess": "key not found: addresess, did you mean 'addresses' or 'address'?",
+		"user.nmae":      "key not found: nmae, did you mean 'name'?",
+		"user.email":     "key not found: email",
+	}
+
+	for path, expected := range tests {
+		t.Run(path, func(t *testing.T) {
+			_, err := r.StrErr(path)
+			if !errors.Is(err, ErrKeyNotFound) {
+				t.Errorf("Expected error: %v, but got: %v", ErrKeyNotFound, err)
+			}
+
+			if err == nil || err.Error() != expected {
+				t.Errorf("Expected: %s but got: %v", expected, err)
+			}
+		})
+	}
+
+	if _, err := StrErr(source, "user.adress"); err == nil || err.Error() != "key not found: adress" {
+		t.Errorf("Suggestions should only be made when enabled, got: %v", err)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"adress", "address", 1},
+		{"kitten", "sitting", 3},
+		{"héllo", "hello", 1},
+		{"nmae", "name", 1},
+	}
+
+	for _, tc := range tests {
+		if result := editDistance(tc.a, tc.b); result != tc.expected {
+			t.Errorf("Expected: %d but got: %d (%s, %s)", tc.expected, result, tc.a, tc.b)
+		}
+	}
+}
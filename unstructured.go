@@ -0,0 +1,113 @@
+package mapreader
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Unstructured is implemented by Kubernetes style unstructured objects, such as *unstructured.Unstructured
+//
+// Plain object maps can be used via mapreader.Object.
+type Unstructured interface {
+	UnstructuredContent() map[string]any
+}
+
+// Object adapts a plain object map (e.g. unstructured.Unstructured.Object) to the Unstructured interface
+type Object map[string]any
+
+// UnstructuredContent returns the object map itself
+func (o Object) UnstructuredContent() map[string]any {
+	return o
+}
+
+// NestedErr returns the value found at the given lookup path of an unstructured object, whether it was found, and any error
+//
+// This mirrors the found/err semantics of the Kubernetes unstructured helpers:
+// a missing value returns found as false with a nil error, whereas a value of the wrong type
+// (or a path that can't be traversed) returns found as false with an error.
+// Unlike the Kubernetes helpers, maps and slices are returned without being deep copied.
+func NestedErr[T any](u Unstructured, path string) (T, bool, error) {
+	return nested(u, path, GetErr[T])
+}
+
+// NestedBool returns the bool value found at the given lookup path of an unstructured object
+//
+// See mapreader.NestedErr for the meaning of the returned found flag and error.
+func NestedBool(u Unstructured, path string) (bool, bool, error) {
+	return nested(u, path, BoolErr)
+}
+
+// NestedFloat64 returns the numeric value found at the given lookup path of an unstructured object as a float64
+//
+// See mapreader.NestedErr for the meaning of the returned found flag and error.
+func NestedFloat64(u Unstructured, path string) (float64, bool, error) {
+	return nested(u, path, Float64Err)
+}
+
+// NestedInt64 returns the numeric value found at the given lookup path of an unstructured object as an int64
+//
+// See mapreader.NestedErr for the meaning of the returned found flag and error.
+func NestedInt64(u Unstructured, path string) (int64, bool, error) {
+	return nested(u, path, NumberErr[int64])
+}
+
+// NestedMap returns the map found at the given lookup path of an unstructured object
+//
+// See mapreader.NestedErr for the meaning of the returned found flag and error.
+func NestedMap(u Unstructured, path string) (map[string]any, bool, error) {
+	return nested(u, path, GetErr[map[string]any])
+}
+
+// NestedSlice returns the slice found at the given lookup path of an unstructured object
+//
+// See mapreader.NestedErr for the meaning of the returned found flag and error.
+func NestedSlice(u Unstructured, path string) ([]any, bool, error) {
+	return nested(u, path, GetErr[[]any])
+}
+
+// NestedString returns the string value found at the given lookup path of an unstructured object
+//
+// See mapreader.NestedErr for the meaning of the returned found flag and error.
+func NestedString(u Unstructured, path string) (string, bool, error) {
+	return nested(u, path, StrErr)
+}
+
+// NestedStringMap returns the map of strings found at the given lookup path of an unstructured object
+//
+// See mapreader.NestedErr for the meaning of the returned found flag and error.
+func NestedStringMap(u Unstructured, path string) (map[string]string, bool, error) {
+	return nested(u, path, MapErr[string])
+}
+
+// NestedStringSlice returns the slice of strings found at the given lookup path of an unstructured object
+//
+// See mapreader.NestedErr for the meaning of the returned found flag and error.
+func NestedStringSlice(u Unstructured, path string) ([]string, bool, error) {
+	return nested(u, path, SliceErr[string])
+}
+
+// nested calls the given getter against the content of an unstructured object, translating missing values into found = false
+//
+// Unlike the package getters, a final key against a value that isn't a map or slice is an error rather than
+// returning that value, matching the Kubernetes helpers.
+func nested[T any](u Unstructured, path string, get func(map[string]any, string) (T, error)) (T, bool, error) {
+	content := u.UnstructuredContent()
+	base, _ := cutModifiers(path)
+	if parent, key, ok := cutLastSegment(base); ok {
+		if value, err := lookup(content, parent, &defaultOptions); err == nil && !isContainer(value) {
+			var zero T
+			err = fmt.Errorf("%w: last key was '%s'", ErrEndOfNestedStructures, key)
+			return zero, false, lookupError(path, err, &defaultOptions)
+		}
+	}
+
+	result, err := get(content, path)
+	switch {
+	case err == nil:
+		return result, true, nil
+	case errors.Is(err, ErrKeyNotFound), errors.Is(err, ErrIndexOutOfBounds):
+		return result, false, nil
+	default:
+		return result, false, err
+	}
+}
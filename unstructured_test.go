@@ -0,0 +1,71 @@
+package mapreader
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type testUnstructured struct {
+	Object map[string]any
+}
+
+func (u *testUnstructured) UnstructuredContent() map[string]any {
+	return u.Object
+}
+
+func TestNested(t *testing.T) {
+	u := &testUnstructured{
+		Object: map[string]any{
+			"metadata": map[string]any{
+				"name":   "example",
+				"labels": map[string]any{"app": "web"},
+			},
+			"spec": map[string]any{
+				"replicas": int64(3),
+				"paused":   false,
+				"args":     []any{"--verbose"},
+			},
+		},
+	}
+
+	name, found, err := NestedString(u, "metadata.name")
+	if name != "example" || !found || err != nil {
+		t.Errorf("Expected: (example, true, nil) but got: (%s, %t, %v)", name, found, err)
+	}
+
+	replicas, found, err := NestedInt64(u, "spec.replicas")
+	if replicas != 3 || !found || err != nil {
+		t.Errorf("Expected: (3, true, nil) but got: (%d, %t, %v)", replicas, found, err)
+	}
+
+	paused, found, err := NestedBool(Object(u.Object), "spec.paused")
+	if paused || !found || err != nil {
+		t.Errorf("Expected: (false, true, nil) but got: (%t, %t, %v)", paused, found, err)
+	}
+
+	args, found, err := NestedStringSlice(u, "spec.args")
+	if !reflect.DeepEqual(args, []string{"--verbose"}) || !found || err != nil {
+		t.Errorf("Expected: ([--verbose], true, nil) but got: (%v, %t, %v)", args, found, err)
+	}
+
+	labels, found, err := NestedStringMap(u, "metadata.labels")
+	if !reflect.DeepEqual(labels, map[string]string{"app": "web"}) || !found || err != nil {
+		t.Errorf("Expected: (map[app:web], true, nil) but got: (%v, %t, %v)", labels, found, err)
+	}
+
+	_, found, err = NestedString(u, "metadata.namespace")
+	if found || err != nil {
+		t.Errorf("Missing values should not be found or return an error, got: (%t, %v)", found, err)
+	}
+
+	_, found, err = NestedString(u, "spec.replicas")
+	if found || !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: (%t, %v)", ErrUnexpectedType, found, err)
+	}
+
+	_, found, err = NestedMap(u, "metadata.name.first")
+	if found || !errors.Is(err, ErrEndOfNestedStructures) {
+		t.Errorf("Expected error: %v, but got: (%t, %v)", ErrEndOfNestedStructures, found, err)
+	}
+}
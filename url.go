@@ -0,0 +1,74 @@
+package mapreader
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// URL returns the URL found at the given lookup path, ignoring any errors
+//
+// If any error is encountered, it returns nil.
+// Use mapreader.URLErr if you would like errors to be returned
+func URL(source map[string]any, path string) *url.URL {
+	return withoutError(URLErr(source, path))
+}
+
+// URLDefault returns the URL found at the given lookup path, or the default value
+//
+// The default is only returned for values that would otherwise error/aren't set.
+func URLDefault(source map[string]any, path string, d *url.URL) *url.URL {
+	result, err := URLErr(source, path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// URLDefaultFunc returns the URL found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func URLDefaultFunc(source map[string]any, path string, d func() *url.URL) *url.URL {
+	result, err := URLErr(source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// URLDefaultOK returns the URL found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func URLDefaultOK(source map[string]any, path string, d *url.URL) (*url.URL, bool) {
+	result, err := URLErr(source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// URLErr returns the URL found at the given lookup path, or returns an error
+//
+// Use mapreader.URL if you would like to ignore errors
+// String values are parsed by url.Parse, returning ErrUnableToConvert if they're malformed,
+// while url.URL values are returned as they are.
+func URLErr(source map[string]any, path string) (*url.URL, error) {
+	return get(source, path, &defaultOptions, asURL)
+}
+
+// asURL converts a URL string into a *url.URL
+func asURL(in any) (*url.URL, error) {
+	switch v := in.(type) {
+	case string:
+		u, err := url.Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrUnableToConvert, err)
+		}
+
+		return u, nil
+	case *url.URL:
+		return v, nil
+	case url.URL:
+		return &v, nil
+	default:
+		return nil, fmt.Errorf("%w: expected a URL string, got %T", ErrUnexpectedType, in)
+	}
+}
@@ -0,0 +1,56 @@
+package mapreader
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+)
+
+func TestURLErr(t *testing.T) {
+	parsed, _ := url.Parse("https://example.com/b")
+	source := map[string]any{
+		"webhook": map[string]any{
+			"url":      "https://example.com/hooks?id=1",
+			"relative": "/callback",
+			"invalid":  "http://[::1",
+			"control":  "https://example.com/\x7f",
+			"number":   float64(1),
+			"parsed":   parsed,
+		},
+	}
+
+	tests := map[string]struct {
+		path        string
+		expected    string
+		expectedErr error
+	}{
+		"Absolute URL":     {path: "webhook.url", expected: "https://example.com/hooks?id=1"},
+		"Relative URL":     {path: "webhook.relative", expected: "/callback"},
+		"Parsed URL":       {path: "webhook.parsed", expected: "https://example.com/b"},
+		"Malformed host":   {path: "webhook.invalid", expectedErr: ErrUnableToConvert},
+		"Control char":     {path: "webhook.control", expectedErr: ErrUnableToConvert},
+		"Unsupported type": {path: "webhook.number", expectedErr: ErrUnexpectedType},
+		"Missing key":      {path: "webhook.missing", expectedErr: ErrKeyNotFound},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := URLErr(source, tc.path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if tc.expectedErr == nil && result.String() != tc.expected {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+
+	if u := URL(source, "webhook.url"); u == nil || u.Host != "example.com" {
+		t.Errorf("Expected host: example.com but got: %v", u)
+	}
+
+	if u := New(source).URLDefault("webhook.missing", parsed); u != parsed {
+		t.Errorf("Expected: %v but got: %v", parsed, u)
+	}
+}
@@ -0,0 +1,98 @@
+package mapreader
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+)
+
+// UUID returns the UUID found at the given lookup path, ignoring any errors
+//
+// If any error is encountered, it returns the zero UUID.
+// Use mapreader.UUIDErr if you would like errors to be returned
+func UUID(source map[string]any, path string) [16]byte {
+	return withoutError(UUIDErr(source, path))
+}
+
+// UUIDDefault returns the UUID found at the given lookup path, or the default value
+//
+// The default is only returned for values that would otherwise error/aren't set.
+func UUIDDefault(source map[string]any, path string, d [16]byte) [16]byte {
+	result, err := UUIDErr(source, path)
+	if err != nil {
+		return d
+	}
+
+	return result
+}
+
+// UUIDDefaultFunc returns the UUID found at the given lookup path, or the result of calling the default function as mapreader.GetDefaultFunc does
+func UUIDDefaultFunc(source map[string]any, path string, d func() [16]byte) [16]byte {
+	result, err := UUIDErr(source, path)
+	if err != nil {
+		return d()
+	}
+
+	return result
+}
+
+// UUIDDefaultOK returns the UUID found at the given lookup path, or the default value, reporting whether the default was used as mapreader.GetDefaultOK does
+func UUIDDefaultOK(source map[string]any, path string, d [16]byte) ([16]byte, bool) {
+	result, err := UUIDErr(source, path)
+	if err != nil {
+		return d, true
+	}
+
+	return result, false
+}
+
+// UUIDErr returns the UUID found at the given lookup path, or returns an error
+//
+// Use mapreader.UUID if you would like to ignore errors
+// Strings must be in the canonical 8-4-4-4-12 hexadecimal form (e.g. "f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+// unless a parser has been set with mapreader.WithUUIDParser. Values which are already 16 byte arrays,
+// such as those of most UUID packages, are returned as they are.
+func UUIDErr(source map[string]any, path string) ([16]byte, error) {
+	return get(source, path, &defaultOptions, asUUID(defaultOptions.UUIDParser))
+}
+
+// ParseUUID parses a UUID in its canonical 8-4-4-4-12 hexadecimal form, in either case
+func ParseUUID(s string) ([16]byte, error) {
+	var u [16]byte
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, fmt.Errorf("%w: %q is not a canonical UUID", ErrUnableToConvert, s)
+	}
+
+	src := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	if _, err := hex.Decode(u[:], []byte(src)); err != nil {
+		return [16]byte{}, fmt.Errorf("%w: %q is not a canonical UUID", ErrUnableToConvert, s)
+	}
+
+	return u, nil
+}
+
+// asUUID converts a UUID string, or a 16 byte array, into the bytes of a UUID, parsing strings with the given parser if set
+func asUUID(parser func(string) ([16]byte, error)) func(any) ([16]byte, error) {
+	return func(in any) ([16]byte, error) {
+		s, ok := in.(string)
+		if !ok {
+			v := reflect.ValueOf(in)
+			if in != nil && v.Type().ConvertibleTo(reflect.TypeFor[[16]byte]()) && v.Kind() == reflect.Array {
+				return v.Convert(reflect.TypeFor[[16]byte]()).Interface().([16]byte), nil
+			}
+
+			return [16]byte{}, fmt.Errorf("%w: expected a UUID string, got %T", ErrUnexpectedType, in)
+		}
+
+		if parser == nil {
+			return ParseUUID(s)
+		}
+
+		u, err := parser(s)
+		if err != nil {
+			return [16]byte{}, fmt.Errorf("%w: %w", ErrUnableToConvert, err)
+		}
+
+		return u, nil
+	}
+}
@@ -0,0 +1,68 @@
+package mapreader
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestUUIDErr(t *testing.T) {
+	expected := [16]byte{0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72, 0xa5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79}
+
+	type vendorUUID [16]byte
+
+	source := map[string]any{
+		"id":        "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+		"upper":     "F47AC10B-58CC-4372-A567-0E02B2C3D479",
+		"braced":    "{f47ac10b-58cc-4372-a567-0e02b2c3d479}",
+		"compact":   "f47ac10b58cc4372a5670e02b2c3d479",
+		"invalid":   "f47ac10b-58cc-4372-a567-0e02b2c3d47z",
+		"misplaced": "f47ac10b5-8cc-4372-a567-0e02b2c3d479",
+		"typed":     vendorUUID(expected),
+		"number":    float64(1),
+	}
+
+	tests := map[string]struct {
+		path        string
+		opts        []Option
+		expected    [16]byte
+		expectedErr error
+	}{
+		"Canonical":        {path: "id", expected: expected},
+		"Upper case":       {path: "upper", expected: expected},
+		"Typed array":      {path: "typed", expected: expected},
+		"Braced":           {path: "braced", expectedErr: ErrUnableToConvert},
+		"Compact":          {path: "compact", expectedErr: ErrUnableToConvert},
+		"Invalid hex":      {path: "invalid", expectedErr: ErrUnableToConvert},
+		"Misplaced hyphen": {path: "misplaced", expectedErr: ErrUnableToConvert},
+		"Number":           {path: "number", expectedErr: ErrUnexpectedType},
+		"Missing key":      {path: "missing", expectedErr: ErrKeyNotFound},
+		"Custom parser": {
+			path:     "braced",
+			opts:     []Option{WithUUIDParser(func(s string) ([16]byte, error) { return ParseUUID(strings.Trim(s, "{}")) })},
+			expected: expected,
+		},
+		"Custom parser error": {
+			path:        "id",
+			opts:        []Option{WithUUIDParser(func(string) ([16]byte, error) { return [16]byte{}, errors.New("rejected") })},
+			expectedErr: ErrUnableToConvert,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := New(source, tc.opts...).UUIDErr(tc.path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("Expected: %x but got: %x", tc.expected, result)
+			}
+		})
+	}
+
+	if result := UUID(source, "id"); result != expected {
+		t.Errorf("Expected: %x but got: %x", expected, result)
+	}
+}
@@ -0,0 +1,83 @@
+package mapreader
+
+// ViperAdapter exposes a Reader through the common method set of spf13/viper
+//
+// This allows code written against viper style interfaces to read from a mapreader source.
+// Unlike viper, type conversion follows the mapreader rules, so GetInt will accept a float64(1)
+// but not a string "1". Any failed lookup returns the zero value, as viper does.
+type ViperAdapter struct {
+	r *Reader
+}
+
+// Viper returns a ViperAdapter for the Reader
+func (r *Reader) Viper() *ViperAdapter {
+	return &ViperAdapter{r: r}
+}
+
+// AllSettings returns the underlying source document
+func (v *ViperAdapter) AllSettings() map[string]any {
+	return v.r.source
+}
+
+// Get returns the value found at the given key, or nil
+func (v *ViperAdapter) Get(key string) any {
+	return Read[any](v.r, key)
+}
+
+// GetBool returns the bool value found at the given key, or false
+func (v *ViperAdapter) GetBool(key string) bool {
+	return v.r.Bool(key)
+}
+
+// GetFloat64 returns the numeric value found at the given key as a float64, or 0
+func (v *ViperAdapter) GetFloat64(key string) float64 {
+	return v.r.Float64(key)
+}
+
+// GetInt returns the numeric value found at the given key as an int, or 0
+func (v *ViperAdapter) GetInt(key string) int {
+	return v.r.Int(key)
+}
+
+// GetInt64 returns the numeric value found at the given key as an int64, or 0
+func (v *ViperAdapter) GetInt64(key string) int64 {
+	return withoutError(get(v.r.source, key, &v.r.opts, asNumber[int64](&v.r.opts)))
+}
+
+// GetString returns the string value found at the given key, or the empty string
+func (v *ViperAdapter) GetString(key string) string {
+	return v.r.Str(key)
+}
+
+// GetStringMap returns the map found at the given key, or nil
+func (v *ViperAdapter) GetStringMap(key string) map[string]any {
+	return Read[map[string]any](v.r, key)
+}
+
+// GetStringMapString returns the map of strings found at the given key, or nil
+func (v *ViperAdapter) GetStringMapString(key string) map[string]string {
+	return withoutError(get(v.r.source, key, &v.r.opts, asMapType[string]))
+}
+
+// GetStringSlice returns the slice of strings found at the given key, or nil
+func (v *ViperAdapter) GetStringSlice(key string) []string {
+	return withoutError(get(v.r.source, key, &v.r.opts, asSliceType[string]))
+}
+
+// IsSet reports whether a value exists at the given key
+func (v *ViperAdapter) IsSet(key string) bool {
+	_, err := ReadErr[any](v.r, key)
+	return err == nil
+}
+
+// Sub returns a ViperAdapter for the map found at the given key, or nil if there isn't one
+//
+// The returned adapter shares the options of its parent.
+func (v *ViperAdapter) Sub(key string) *ViperAdapter {
+	source, err := ReadErr[map[string]any](v.r, key)
+	if err != nil {
+		return nil
+	}
+
+	return &ViperAdapter{r: &Reader{source: source, opts: v.r.opts}}
+}
@@ -0,0 +1,82 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestViperAdapter(t *testing.T) {
+	source := map[string]any{}
+	err := json.Unmarshal([]byte(`{
+		"server": {"host": "localhost", "port": 8080, "debug": true, "ratio": 0.5},
+		"hosts": ["a", "b"],
+		"labels": {"env": "prod"}
+	}`), &source)
+	if err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	v := New(source).Viper()
+
+	if result := v.GetString("server.host"); result != "localhost" {
+		t.Errorf("Expected: localhost but got: %s", result)
+	}
+
+	if result := v.GetInt("server.port"); result != 8080 {
+		t.Errorf("Expected: 8080 but got: %d", result)
+	}
+
+	if result := v.GetInt64("server.port"); result != 8080 {
+		t.Errorf("Expected: 8080 but got: %d", result)
+	}
+
+	if result := v.GetBool("server.debug"); !result {
+		t.Error("Expected: true but got: false")
+	}
+
+	if result := v.GetFloat64("server.ratio"); result != 0.5 {
+		t.Errorf("Expected: 0.5 but got: %v", result)
+	}
+
+	if result := v.GetStringSlice("hosts"); !reflect.DeepEqual(result, []string{"a", "b"}) {
+		t.Errorf("Expected: [a b] but got: %v", result)
+	}
+
+	if result := v.GetStringMapString("labels"); !reflect.DeepEqual(result, map[string]string{"env": "prod"}) {
+		t.Errorf("Expected: map[env:prod] but got: %v", result)
+	}
+
+	if result := v.GetString("server.missing"); result != "" {
+		t.Errorf("Expected the empty string but got: %s", result)
+	}
+
+	if !v.IsSet("server.port") || v.IsSet("server.missing") {
+		t.Error("IsSet should only report existing keys")
+	}
+
+	sub := v.Sub("server")
+	if sub == nil || sub.GetInt("port") != 8080 || sub.Get("host") != "localhost" {
+		t.Errorf("Sub should return an adapter for the nested map, got: %v", sub)
+	}
+
+	if v.Sub("hosts") != nil {
+		t.Error("Sub should return nil for non-map values")
+	}
+}
+
+func TestViperAdapterNumberParsing(t *testing.T) {
+	v := New(map[string]any{"server": map[string]any{"port": "8080"}}, WithNumberParsing()).Viper()
+
+	if result := v.GetInt("server.port"); result != 8080 {
+		t.Errorf("Expected: 8080 but got: %d", result)
+	}
+
+	if result := v.GetInt64("server.port"); result != 8080 {
+		t.Errorf("Expected: 8080 but got: %d", result)
+	}
+
+	if result := v.GetFloat64("server.port"); result != 8080 {
+		t.Errorf("Expected: 8080 but got: %v", result)
+	}
+}
@@ -0,0 +1,103 @@
+package mapreader
+
+import "strings"
+
+// Walk calls the function with the lookup path and value of every node of the source, depth first
+//
+// Maps are visited in key order and slices in index order, with each container visited before its children.
+// Returning false from the function skips the children of the node it was called with.
+// Paths are encoded as by mapreader.PathOf, so each can be used to look up the node it was called with,
+// e.g. {"a": {"b.c": [1]}} visits "a", `a.b\.c` and `a.b\.c.0`.
+func Walk(source map[string]any, fn func(path string, value any) bool) {
+	walk(source, "", &defaultOptions, fn)
+}
+
+// ListPaths returns the lookup path of every leaf of the source, in the order visited by mapreader.Walk
+//
+// Leaves are values other than maps and slices, along with any empty maps and slices.
+// Paths are escaped as by mapreader.PathOf, e.g. {"a": {"b.c": [1, 2]}} returns `a.b\.c.0` and `a.b\.c.1`.
+func ListPaths(source map[string]any) []string {
+	return listPaths(source, &defaultOptions)
+}
+
+// listPaths returns the path of every leaf of the source
+func listPaths(source map[string]any, opts *Options) []string {
+	var paths []string
+	walk(source, "", opts, func(path string, value any) bool {
+		if _, ok := value.([]byte); ok || len(childKeys(value)) == 0 {
+			paths = append(paths, path)
+		}
+		return true
+	})
+
+	return paths
+}
+
+// FindKey returns the lookup path of every map key matching the given key, anywhere in the source
+//
+// e.g. FindKey(source, "customer_id") on {"order": {"customer_id": 1}, "refunds": [{"customer_id": 1}]}
+// returns ["order.customer_id", "refunds.0.customer_id"]. Paths are in the order visited by mapreader.Walk,
+// and slice indexes never match, even if the key is an integer.
+func FindKey(source map[string]any, key string) []string {
+	return findKey(source, key, &defaultOptions)
+}
+
+// findKey returns the path of every map key matching the given key, ignoring case if the options allow
+func findKey(source map[string]any, key string, opts *Options) []string {
+	var paths []string
+	walk(source, "", opts, func(path string, value any) bool {
+		parentPath, k, hasParent := cutLastSegment(path)
+		if k != key && !(opts.CaseInsensitiveKeys && strings.EqualFold(k, key)) {
+			return true
+		}
+
+		if isIndex(k) && hasParent {
+			if parent, err := lookup(source, parentPath, opts); err != nil || isSlice(parent) {
+				return true
+			}
+		}
+
+		paths = append(paths, path)
+		return true
+	})
+
+	return paths
+}
+
+// walk calls the function with each child of the current value, descending into those it returns true for
+//
+// A []byte is treated as a single value rather than a slice of bytes.
+func walk(current any, prefix string, opts *Options, fn func(path string, value any) bool) {
+	if _, ok := current.([]byte); ok {
+		return
+	}
+
+	for _, k := range childKeys(current) {
+		child, err := step(current, k, opts)
+		if err != nil {
+			continue
+		}
+
+		path := joinPath(prefix, k)
+		if fn(path, child) {
+			walk(child, path, opts, fn)
+		}
+	}
+}
+
+// Walk is the Reader equivalent of mapreader.Walk
+func (r *Reader) Walk(fn func(path string, value any) bool) {
+	walk(r.source, "", &r.opts, fn)
+}
+
+// FindKey is the Reader equivalent of mapreader.FindKey
+//
+// With mapreader.WithCaseInsensitiveKeys, keys differing from the given key only by case also match.
+func (r *Reader) FindKey(key string) []string {
+	return findKey(r.source, key, &r.opts)
+}
+
+// ListPaths is the Reader equivalent of mapreader.ListPaths
+func (r *Reader) ListPaths() []string {
+	return listPaths(r.source, &r.opts)
+}
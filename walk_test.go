@@ -0,0 +1,97 @@
+package mapreader
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWalk(t *testing.T) {
+	source := map[string]any{
+		"a": map[string]any{
+			"b.c": []any{1, map[string]any{"d": true}},
+		},
+		"secret": map[string]any{"token": "x"},
+		"typed":  map[string]int{"n": 1},
+		"raw":    []byte("xy"),
+	}
+
+	var paths []string
+	Walk(source, func(path string, value any) bool {
+		paths = append(paths, path)
+		return path != "secret"
+	})
+
+	expected := []string{"a", `a.b\.c`, `a.b\.c.0`, `a.b\.c.1`, `a.b\.c.1.d`, "raw", "secret", "typed", "typed.n"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("Expected: %v but got: %v", expected, paths)
+	}
+
+	New(source).Walk(func(path string, value any) bool {
+		if found := Get[any](source, path); !reflect.DeepEqual(found, value) {
+			t.Errorf("Expected path %s to find: %v but got: %v", path, value, found)
+		}
+		return true
+	})
+}
+
+func TestListPaths(t *testing.T) {
+	source := map[string]any{
+		"a": map[string]any{
+			"b.c": []any{1, 2},
+			"d":   map[string]any{},
+		},
+		"e":    nil,
+		"list": []any{},
+		"raw":  []byte("xy"),
+		"f[0]": "g",
+	}
+
+	expected := []string{`a.b\.c.0`, `a.b\.c.1`, "a.d", "e", `f\[0\]`, "list", "raw"}
+	if paths := ListPaths(source); !reflect.DeepEqual(paths, expected) {
+		t.Errorf("Expected: %v but got: %v", expected, paths)
+	}
+
+	for _, path := range New(source).ListPaths() {
+		if !Has(source, path) {
+			t.Errorf("Expected path %s to be found", path)
+		}
+	}
+
+	if paths := ListPaths(map[string]any{}); paths != nil {
+		t.Errorf("Expected: nil but got: %v", paths)
+	}
+}
+
+func TestFindKey(t *testing.T) {
+	source := map[string]any{
+		"order": map[string]any{
+			"customer_id": 1,
+			"customer":    map[string]any{"customer_id": 1, "Customer_ID": 2},
+		},
+		"refunds": []any{
+			map[string]any{"customer_id": 1},
+			map[string]any{"amount": 5},
+		},
+		"customer_id": 3,
+		"lookup":      map[string]any{"0": "zero"},
+		"list":        []any{"a"},
+	}
+
+	expected := []string{"customer_id", "order.customer.customer_id", "order.customer_id", "refunds.0.customer_id"}
+	if paths := FindKey(source, "customer_id"); !reflect.DeepEqual(paths, expected) {
+		t.Errorf("Expected: %v but got: %v", expected, paths)
+	}
+
+	expected = []string{"customer_id", "order.customer.Customer_ID", "order.customer.customer_id", "order.customer_id", "refunds.0.customer_id"}
+	if paths := New(source, WithCaseInsensitiveKeys()).FindKey("customer_id"); !reflect.DeepEqual(paths, expected) {
+		t.Errorf("Expected: %v but got: %v", expected, paths)
+	}
+
+	if paths := FindKey(source, "0"); !reflect.DeepEqual(paths, []string{"lookup.0"}) {
+		t.Errorf("Expected: [lookup.0] but got: %v", paths)
+	}
+
+	if paths := FindKey(source, "missing"); paths != nil {
+		t.Errorf("Expected: nil but got: %v", paths)
+	}
+}
@@ -0,0 +1,170 @@
+package mapreader
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GetAll returns every value matched by a lookup path containing wildcards, ignoring any errors
+//
+// Use mapreader.GetAllErr if you would like errors to be returned
+func GetAll[T any](source map[string]any, path string) []T {
+	return withoutError(GetAllErr[T](source, path))
+}
+
+// GetAllErr returns every value matched by a lookup path containing wildcards, or returns the first error
+//
+// A "*" (or "[*]") segment matches every element of a slice, or every value of a map in key order,
+// e.g. "users.*.email" returns the email of every user. Use `\*` or `["*"]` to look up a literal "*" key.
+// Matches where the remainder of the path doesn't resolve are skipped, so users without an email are left out.
+// Errors before the first wildcard, or converting a match, are returned, annotated with the path that failed.
+func GetAllErr[T any](source map[string]any, path string) ([]T, error) {
+	return getAll[T](source, path, &defaultOptions)
+}
+
+// getAll looks up and converts every value matched by a lookup path containing wildcards
+func getAll[T any](source map[string]any, path string, opts *Options) ([]T, error) {
+	base, modifiers := cutModifiers(path)
+
+	var matches []Result
+	if err := lookupAll(source, base, "", opts, false, &matches); err != nil {
+		return nil, lookupError(path, err, opts)
+	}
+
+	result := make([]T, len(matches))
+	for i, m := range matches {
+		if len(modifiers) > 0 {
+			modified, err := applyModifiers(m.Value, modifiers, opts)
+			if err != nil {
+				return nil, lookupError(path, fmt.Errorf("path '%s': %w", m.Path, err), opts)
+			}
+			m.Value = modified
+		}
+
+		value, err := convertValue(m.Value, opts, asType[T])
+		if err != nil {
+			return nil, lookupError(path, fmt.Errorf("path '%s': %w", m.Path, err), opts)
+		}
+		result[i] = value
+	}
+
+	return result, nil
+}
+
+// lookupAll appends every value matched by the path beneath current to matches, along with their concrete paths
+//
+// Once a wildcard has been expanded, any match that fails to resolve is skipped rather than returning an error.
+func lookupAll(current any, path, prefix string, opts *Options, expanded bool, matches *[]Result) error {
+	for {
+		key, rest, more, literal := cutLiteralSegment(path)
+		segment := strings.TrimSuffix(path[:len(path)-len(rest)], ".")
+
+		if path == "#" {
+			n, err := length(current, opts)
+			if err != nil {
+				if expanded {
+					return nil
+				}
+
+				return err
+			}
+
+			*matches = append(*matches, Result{Path: strings.TrimPrefix(prefix+".#", "."), Value: n})
+			return nil
+		}
+
+		if segment == "*" || segment == "[*]" {
+			for _, k := range childKeys(current) {
+				child, err := step(current, k, opts)
+				if err != nil {
+					continue
+				}
+
+				childPath := joinPath(prefix, k)
+				if !more {
+					*matches = append(*matches, Result{Path: childPath, Value: foundValue(child, opts)})
+					continue
+				}
+
+				if err := lookupAll(traversable(child, opts), rest, childPath, opts, true, matches); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+
+		next, err := stepSegment(current, key, literal, opts)
+		if err != nil {
+			if expanded {
+				return nil
+			}
+
+			return err
+		}
+
+		current = next
+		if prefix == "" {
+			prefix = segment
+		} else {
+			prefix += "." + segment
+		}
+
+		if !more {
+			*matches = append(*matches, Result{Path: prefix, Value: foundValue(current, opts)})
+			return nil
+		}
+
+		current = traversable(current, opts)
+		path = rest
+	}
+}
+
+// childKeys returns the path segments of every child of a map or slice, with map keys sorted
+func childKeys(value any) []string {
+	switch v := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		return keys
+	case []any:
+		return indexKeys(len(v))
+	}
+
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Map:
+		keys := make([]string, 0, rv.Len())
+		for _, k := range rv.MapKeys() {
+			keys = append(keys, fmt.Sprint(k.Interface()))
+		}
+		sort.Strings(keys)
+
+		return keys
+	case reflect.Slice, reflect.Array:
+		return indexKeys(rv.Len())
+	default:
+		return nil
+	}
+}
+
+// indexKeys returns the path segments of each index of a slice with the given length
+func indexKeys(length int) []string {
+	keys := make([]string, length)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+	}
+
+	return keys
+}
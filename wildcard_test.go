@@ -0,0 +1,88 @@
+package mapreader
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestGetAllErr(t *testing.T) {
+	source := map[string]any{
+		"users": []any{
+			map[string]any{"email": "jo@example.com", "roles": []any{"admin", "dev"}},
+			map[string]any{"name": "no email"},
+			map[string]any{"email": "dan@example.com", "roles": []any{"dev"}},
+		},
+		"scores": map[string]any{"b": 2.0, "a": 1.0, "c": 3.0},
+		"typed":  map[string][]int{"x": {1, 2}, "y": {3}},
+		"*":      "literal",
+		"mixed":  []any{1.0, "two"},
+	}
+
+	tests := map[string]struct {
+		expected    []any
+		expectedErr error
+	}{
+		"users.*.email":   {expected: []any{"jo@example.com", "dan@example.com"}},
+		"users[*].email":  {expected: []any{"jo@example.com", "dan@example.com"}},
+		"users.*.roles.*": {expected: []any{"admin", "dev", "dev"}},
+		"users.0.roles.*": {expected: []any{"admin", "dev"}},
+		"scores.*":        {expected: []any{1.0, 2.0, 3.0}},
+		"typed.*.0":       {expected: []any{1, 3}},
+		"users.*.missing": {expected: []any{}},
+		"users.1":         {expected: []any{map[string]any{"name": "no email"}}},
+		`\*`:              {expected: []any{"literal"}},
+		`["*"]`:           {expected: []any{"literal"}},
+		"users.*.roles.#": {expected: []any{2, 1}},
+		"missing.*":       {expectedErr: ErrKeyNotFound},
+	}
+
+	for path, tc := range tests {
+		t.Run(path, func(t *testing.T) {
+			result, err := GetAllErr[any](source, path)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("Expected error: %v, but got: %v", tc.expectedErr, err)
+			}
+
+			if tc.expectedErr == nil && !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Expected: %v but got: %v", tc.expected, result)
+			}
+		})
+	}
+
+	if _, err := GetAllErr[string](source, "mixed.*"); !errors.Is(err, ErrUnexpectedType) {
+		t.Errorf("Expected error: %v, but got: %v", ErrUnexpectedType, err)
+	}
+
+	if result := GetAll[string](source, "users.*.email"); !reflect.DeepEqual(result, []string{"jo@example.com", "dan@example.com"}) {
+		t.Errorf("Expected typed emails but got: %v", result)
+	}
+}
+
+func TestReadAllAttributeValues(t *testing.T) {
+	source := map[string]any{}
+	err := json.Unmarshal([]byte(`{
+		"users": {"L": [
+			{"M": {"name": {"S": "jo"}, "tags": {"L": [{"S": "a"}]}}},
+			{"M": {"name": {"S": "dan"}, "tags": {"L": [{"S": "b"}, {"S": "c"}]}}}
+		]}
+	}`), &source)
+	if err != nil {
+		t.Fatalf("Unable to unmarshal test input: %s", err.Error())
+	}
+
+	r := New(source, WithAttributeValueUnwrap())
+
+	if result, err := ReadAllErr[string](r, "users.*.name"); err != nil || !reflect.DeepEqual(result, []string{"jo", "dan"}) {
+		t.Errorf("Expected: [jo dan] but got: %v (%v)", result, err)
+	}
+
+	if result, err := ReadAllErr[string](r, "users.*.tags.*"); err != nil || !reflect.DeepEqual(result, []string{"a", "b", "c"}) {
+		t.Errorf("Expected: [a b c] but got: %v (%v)", result, err)
+	}
+
+	if result, err := ReadAllErr[string](r, "users.1.name"); err != nil || !reflect.DeepEqual(result, []string{"dan"}) {
+		t.Errorf("Expected: [dan] but got: %v (%v)", result, err)
+	}
+}